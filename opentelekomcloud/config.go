@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/huaweicloud/golangsdk"
 	huaweisdk "github.com/huaweicloud/golangsdk/openstack"
+	"github.com/terraform-providers/terraform-provider-opentelekomcloud/opentelekomcloud/obs"
 )
 
 type Config struct {
@@ -44,6 +45,9 @@ type Config struct {
 	Username         string
 	UserID           string
 
+	// DefaultTags are applied to all the resources supporting tags.
+	DefaultTags map[string]string
+
 	OsClient *gophercloud.ProviderClient
 	HwClient *golangsdk.ProviderClient
 	s3sess   *session.Session
@@ -465,3 +469,47 @@ func (c *Config) sfsV2Client(region string) (*golangsdk.ServiceClient, error) {
 		Availability: c.getHwEndpointType(),
 	})
 }
+
+func (c *Config) ctsV1Client(region string) (*golangsdk.ServiceClient, error) {
+	return huaweisdk.NewCTSService(c.HwClient, golangsdk.EndpointOpts{
+		Region:       c.determineRegion(region),
+		Availability: c.getHwEndpointType(),
+	})
+}
+
+func (c *Config) ltsV2Client(region string) (*golangsdk.ServiceClient, error) {
+	return huaweisdk.NewLTSV2(c.HwClient, golangsdk.EndpointOpts{
+		Region:       c.determineRegion(region),
+		Availability: c.getHwEndpointType(),
+	})
+}
+
+func (c *Config) ltsV3Client(region string) (*golangsdk.ServiceClient, error) {
+	return huaweisdk.NewLTSV3(c.HwClient, golangsdk.EndpointOpts{
+		Region:       c.determineRegion(region),
+		Availability: c.getHwEndpointType(),
+	})
+}
+
+func (c *Config) sfsTurboV1Client(region string) (*golangsdk.ServiceClient, error) {
+	return huaweisdk.NewSFSTurboV1(c.HwClient, golangsdk.EndpointOpts{
+		Region:       c.determineRegion(region),
+		Availability: c.getHwEndpointType(),
+	})
+}
+
+func (c *Config) newObjectStorageClient(region string) (*obs.ObsClient, error) {
+	if c.AccessKey == "" || c.SecretKey == "" {
+		return nil, fmt.Errorf("missing credentials for OBS, need access_key and secret_key values for provider")
+	}
+
+	client, err := huaweisdk.NewOBSService(c.HwClient, golangsdk.EndpointOpts{
+		Region:       c.determineRegion(region),
+		Availability: c.getHwEndpointType(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return obs.New(c.AccessKey, c.SecretKey, client.Endpoint, obs.WithHttpClient(&c.HwClient.HTTPClient))
+}
@@ -0,0 +1,123 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk/openstack/cloudeyeservice/events"
+)
+
+func dataSourceCESEvents() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCESEventsRead,
+
+		Schema: map[string]*schema.Schema{
+			"event_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"EVENT.SYS", "EVENT.CUSTOM"})
+				},
+			},
+			"event_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"from": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRFC3339Timestamp,
+			},
+			"to": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRFC3339Timestamp,
+			},
+			"events": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_count": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"latest_occur_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"latest_event_source": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCESEventsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := chooseCESClient(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating Cloud Eye Service client: %s", err)
+	}
+
+	listOpts := events.ListOpts{
+		EventType: d.Get("event_type").(string),
+		EventName: d.Get("event_name").(string),
+		Limit:     100,
+	}
+	if v, ok := d.GetOk("from"); ok {
+		from, _ := time.Parse(time.RFC3339, v.(string))
+		listOpts.From = from.UnixNano() / int64(time.Millisecond)
+	}
+	if v, ok := d.GetOk("to"); ok {
+		to, _ := time.Parse(time.RFC3339, v.(string))
+		listOpts.To = to.UnixNano() / int64(time.Millisecond)
+	}
+
+	var allEvents []events.Event
+	for {
+		page, err := events.List(client, listOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve Cloud Eye events: %s", err)
+		}
+		allEvents = append(allEvents, page.Events...)
+
+		if len(page.Events) < listOpts.Limit || len(allEvents) >= page.MetaData.Total {
+			break
+		}
+		listOpts.Start = len(allEvents)
+	}
+	log.Printf("[DEBUG] Retrieved %d Cloud Eye events", len(allEvents))
+
+	s := make([]map[string]interface{}, 0, len(allEvents))
+	for _, event := range allEvents {
+		s = append(s, map[string]interface{}{
+			"event_name":          event.EventName,
+			"event_type":          event.EventType,
+			"event_count":         event.EventCount,
+			"latest_occur_time":   time.Unix(0, event.LatestOccurTime*int64(time.Millisecond)).UTC().Format(time.RFC3339),
+			"latest_event_source": event.LatestEventSource,
+		})
+	}
+
+	d.SetId(time.Now().UTC().String())
+	if err := d.Set("events", s); err != nil {
+		return err
+	}
+
+	return nil
+}
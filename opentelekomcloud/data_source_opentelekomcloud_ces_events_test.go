@@ -0,0 +1,30 @@
+package opentelekomcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccCESEventsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCESEventsDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCESDataSourceID("data.opentelekomcloud_ces_events.events"),
+					resource.TestCheckResourceAttrSet(
+						"data.opentelekomcloud_ces_events.events", "events.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCESEventsDataSource_basic = `
+data "opentelekomcloud_ces_events" "events" {
+  event_type = "EVENT.SYS"
+}
+`
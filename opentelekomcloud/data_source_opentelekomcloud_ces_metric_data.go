@@ -0,0 +1,165 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk/openstack/cloudeyeservice/metricdata"
+)
+
+func dataSourceCESMetricData() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCESMetricDataRead,
+
+		Schema: map[string]*schema.Schema{
+			"namespace": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"metric_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"dimensions": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 3,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"from": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRFC3339Timestamp,
+			},
+			"to": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRFC3339Timestamp,
+			},
+			"period": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  300,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(int)
+					switch value {
+					case 1, 300, 1200, 3600, 14400, 86400:
+					default:
+						errors = append(errors, fmt.Errorf("%q must be one of 1, 300, 1200, 3600, 14400 or 86400", k))
+					}
+					return
+				},
+			},
+			"filter": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "average",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"average", "variance", "min", "max", "sum"})
+				},
+			},
+			"datapoints": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timestamp": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": &schema.Schema{
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"unit": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCESMetricDataRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := chooseCESClient(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating Cloud Eye Service client: %s", err)
+	}
+
+	// Query the last hour unless told otherwise
+	to := time.Now()
+	if v, ok := d.GetOk("to"); ok {
+		to, _ = time.Parse(time.RFC3339, v.(string))
+	}
+	from := to.Add(-time.Hour)
+	if v, ok := d.GetOk("from"); ok {
+		from, _ = time.Parse(time.RFC3339, v.(string))
+	}
+
+	getOpts := metricdata.GetOpts{
+		Namespace:  d.Get("namespace").(string),
+		MetricName: d.Get("metric_name").(string),
+		From:       from.UnixNano() / int64(time.Millisecond),
+		To:         to.UnixNano() / int64(time.Millisecond),
+		Period:     d.Get("period").(int),
+		Filter:     d.Get("filter").(string),
+	}
+	for _, v := range d.Get("dimensions").([]interface{}) {
+		dim := v.(map[string]interface{})
+		getOpts.Dimensions = append(getOpts.Dimensions, metricdata.DimensionOpts{
+			Name:  dim["name"].(string),
+			Value: dim["value"].(string),
+		})
+	}
+
+	data, err := metricdata.Get(client, getOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve Cloud Eye metric data: %s", err)
+	}
+	log.Printf("[DEBUG] Retrieved %d datapoints of metric %s.%s", len(data.Datapoints), getOpts.Namespace, getOpts.MetricName)
+
+	s := make([]map[string]interface{}, 0, len(data.Datapoints))
+	for _, dp := range data.Datapoints {
+		var value float64
+		switch getOpts.Filter {
+		case "average":
+			value = dp.Average
+		case "variance":
+			value = dp.Variance
+		case "min":
+			value = dp.Min
+		case "max":
+			value = dp.Max
+		case "sum":
+			value = dp.Sum
+		}
+		s = append(s, map[string]interface{}{
+			"timestamp": time.Unix(0, dp.Timestamp*int64(time.Millisecond)).UTC().Format(time.RFC3339),
+			"value":     value,
+			"unit":      dp.Unit,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s.%s-%d-%d", getOpts.Namespace, getOpts.MetricName, getOpts.From, getOpts.To))
+	if err := d.Set("datapoints", s); err != nil {
+		return err
+	}
+
+	return nil
+}
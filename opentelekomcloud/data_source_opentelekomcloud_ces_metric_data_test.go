@@ -0,0 +1,43 @@
+package opentelekomcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccCESMetricDataDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCESMetricDataDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCESDataSourceID("data.opentelekomcloud_ces_metric_data.cpu"),
+					resource.TestCheckResourceAttrSet(
+						"data.opentelekomcloud_ces_metric_data.cpu", "datapoints.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCESMetricDataDataSource_basic = `
+data "opentelekomcloud_ces_metrics" "metrics" {
+  namespace   = "SYS.ECS"
+  metric_name = "cpu_util"
+}
+
+data "opentelekomcloud_ces_metric_data" "cpu" {
+  namespace   = "SYS.ECS"
+  metric_name = "cpu_util"
+  period      = 300
+  filter      = "max"
+
+  dimensions {
+    name  = "${data.opentelekomcloud_ces_metrics.metrics.metrics.0.dimensions.0.name}"
+    value = "${data.opentelekomcloud_ces_metrics.metrics.metrics.0.dimensions.0.value}"
+  }
+}
+`
@@ -0,0 +1,169 @@
+package opentelekomcloud
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk/openstack/cloudeyeservice/metrics"
+)
+
+func dataSourceCESMetrics() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCESMetricsRead,
+
+		Schema: map[string]*schema.Schema{
+			"namespace": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"metric_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dimensions": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 3,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"namespaces": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"metric_names": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"metrics": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"namespace": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metric_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"unit": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dimensions": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"value": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCESMetricsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := chooseCESClient(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating Cloud Eye Service client: %s", err)
+	}
+
+	listOpts := metrics.ListOpts{
+		Namespace:  d.Get("namespace").(string),
+		MetricName: d.Get("metric_name").(string),
+		Limit:      1000,
+	}
+	for _, v := range d.Get("dimensions").([]interface{}) {
+		dim := v.(map[string]interface{})
+		listOpts.Dimensions = append(listOpts.Dimensions, metrics.DimensionOpts{
+			Name:  dim["name"].(string),
+			Value: dim["value"].(string),
+		})
+	}
+
+	var allMetrics []metrics.Metric
+	for {
+		page, err := metrics.List(client, listOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve Cloud Eye metrics: %s", err)
+		}
+		allMetrics = append(allMetrics, page.Metrics...)
+
+		if page.MetaData.Marker == "" || len(page.Metrics) < listOpts.Limit {
+			break
+		}
+		listOpts.Start = page.MetaData.Marker
+	}
+	log.Printf("[DEBUG] Retrieved %d Cloud Eye metrics", len(allMetrics))
+
+	var namespaces, metricNames []string
+	seenNamespaces := make(map[string]bool)
+	seenMetricNames := make(map[string]bool)
+	var buf bytes.Buffer
+	s := make([]map[string]interface{}, 0, len(allMetrics))
+	for _, metric := range allMetrics {
+		if !seenNamespaces[metric.Namespace] {
+			seenNamespaces[metric.Namespace] = true
+			namespaces = append(namespaces, metric.Namespace)
+		}
+		if !seenMetricNames[metric.MetricName] {
+			seenMetricNames[metric.MetricName] = true
+			metricNames = append(metricNames, metric.MetricName)
+		}
+
+		dims := make([]map[string]interface{}, 0, len(metric.Dimensions))
+		for _, dim := range metric.Dimensions {
+			dims = append(dims, map[string]interface{}{
+				"name":  dim.Name,
+				"value": dim.Value,
+			})
+			buf.WriteString(fmt.Sprintf("%s:%s,", dim.Name, dim.Value))
+		}
+		buf.WriteString(fmt.Sprintf("%s.%s-", metric.Namespace, metric.MetricName))
+
+		s = append(s, map[string]interface{}{
+			"namespace":   metric.Namespace,
+			"metric_name": metric.MetricName,
+			"unit":        metric.Unit,
+			"dimensions":  dims,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(buf.String())))
+	d.Set("namespaces", namespaces)
+	d.Set("metric_names", metricNames)
+	if err := d.Set("metrics", s); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccCESMetricsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCESMetricsDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCESDataSourceID("data.opentelekomcloud_ces_metrics.metrics"),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_ces_metrics.metrics", "namespaces.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_ces_metrics.metrics", "namespaces.0", "SYS.ECS"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCESDataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find Cloud Eye data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Cloud Eye data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+const testAccCESMetricsDataSource_basic = `
+data "opentelekomcloud_ces_metrics" "metrics" {
+  namespace   = "SYS.ECS"
+  metric_name = "cpu_util"
+}
+`
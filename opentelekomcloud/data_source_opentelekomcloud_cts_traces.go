@@ -0,0 +1,199 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk/openstack/cts/v1/traces"
+)
+
+func dataSourceCTSTraces() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCTSTracesRead,
+
+		Schema: map[string]*schema.Schema{
+			"tracker_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "system",
+			},
+			"from": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRFC3339Timestamp,
+			},
+			"to": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRFC3339Timestamp,
+			},
+			"service_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"trace_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"trace_rating": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"normal", "warning", "incident"})
+				},
+			},
+			"user": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"limit": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  50,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateIntRange(v, k, 1, 200)
+				},
+			},
+			"traces": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"trace_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"trace_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"trace_rating": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"trace_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_ip": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"code": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCTSTracesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctsClient, err := config.ctsV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud cts client: %s", err)
+	}
+
+	limit := d.Get("limit").(int)
+	listOpts := traces.ListOpts{
+		Limit:        limit,
+		ServiceType:  d.Get("service_type").(string),
+		ResourceType: d.Get("resource_type").(string),
+		ResourceID:   d.Get("resource_id").(string),
+		ResourceName: d.Get("resource_name").(string),
+		TraceName:    d.Get("trace_name").(string),
+		TraceRating:  d.Get("trace_rating").(string),
+		User:         d.Get("user").(string),
+	}
+	if v, ok := d.GetOk("from"); ok {
+		from, _ := time.Parse(time.RFC3339, v.(string))
+		listOpts.From = from.UnixNano() / int64(time.Millisecond)
+	}
+	if v, ok := d.GetOk("to"); ok {
+		to, _ := time.Parse(time.RFC3339, v.(string))
+		listOpts.To = to.UnixNano() / int64(time.Millisecond)
+	}
+
+	trackerName := d.Get("tracker_name").(string)
+	var allTraces []traces.Trace
+	for {
+		page, err := traces.List(ctsClient, trackerName, listOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve cts traces: %s", err)
+		}
+		allTraces = append(allTraces, page.Traces...)
+
+		if len(allTraces) >= limit || page.MetaData.Marker == "" {
+			break
+		}
+		listOpts.Next = page.MetaData.Marker
+		listOpts.Limit = limit - len(allTraces)
+	}
+	log.Printf("[DEBUG] Retrieved %d cts traces", len(allTraces))
+
+	s := make([]map[string]interface{}, 0, len(allTraces))
+	for _, trace := range allTraces {
+		s = append(s, map[string]interface{}{
+			"trace_id":      trace.TraceID,
+			"trace_name":    trace.TraceName,
+			"trace_rating":  trace.TraceRating,
+			"trace_type":    trace.TraceType,
+			"service_type":  trace.ServiceType,
+			"resource_type": trace.ResourceType,
+			"resource_id":   trace.ResourceID,
+			"resource_name": trace.ResourceName,
+			"source_ip":     trace.SourceIP,
+			"code":          trace.Code,
+			"user_name":     trace.User.Name,
+			"time":          time.Unix(0, trace.Time*int64(time.Millisecond)).UTC().Format(time.RFC3339),
+		})
+	}
+
+	d.SetId(time.Now().UTC().String())
+	if err := d.Set("traces", s); err != nil {
+		return err
+	}
+
+	return nil
+}
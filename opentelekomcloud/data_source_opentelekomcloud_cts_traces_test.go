@@ -0,0 +1,46 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccCTSTracesDataSource_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCTSTracesDataSource_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.opentelekomcloud_cts_traces.traces", "traces.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCTSTracesDataSource_basic(rInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_s3_bucket" "bucket_1" {
+  bucket        = "tf-cts-test-bucket-%d"
+  force_destroy = true
+}
+
+resource "opentelekomcloud_cts_tracker_v1" "tracker_1" {
+  bucket_name = "${opentelekomcloud_s3_bucket.bucket_1.bucket}"
+}
+
+data "opentelekomcloud_cts_traces" "traces" {
+  tracker_name = "${opentelekomcloud_cts_tracker_v1.tracker_1.tracker_name}"
+  service_type = "CTS"
+  limit        = 10
+}
+`, rInt)
+}
@@ -0,0 +1,156 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/huaweicloud/golangsdk/openstack/dns/v2/zones"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceDNSZoneV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDNSZoneV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"zone_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "public",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"public", "private"})
+				},
+			},
+			"email": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ttl": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"masters": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"router": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"router_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"router_region": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dnsZoneV2FQDN lowercases a zone name and appends the trailing dot
+// the DNS service always returns.
+func dnsZoneV2FQDN(name string) string {
+	name = strings.ToLower(name)
+	if !strings.HasSuffix(name, ".") {
+		name += "."
+	}
+	return name
+}
+
+func dataSourceDNSZoneV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud DNS client: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	listOpts := zones.ListOpts{
+		Name: name,
+		Type: d.Get("zone_type").(string),
+	}
+
+	pages, err := zones.List(dnsClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve DNS zones: %s", err)
+	}
+
+	allZones, err := zones.ExtractZones(pages)
+	if err != nil {
+		return fmt.Errorf("Unable to extract DNS zones: %s", err)
+	}
+
+	// The name filter of the API is a fuzzy match
+	var refinedZones []zones.Zone
+	for _, z := range allZones {
+		if dnsZoneV2FQDN(z.Name) == dnsZoneV2FQDN(name) {
+			refinedZones = append(refinedZones, z)
+		}
+	}
+
+	if len(refinedZones) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(refinedZones) > 1 {
+		return fmt.Errorf("Your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+
+	zone := refinedZones[0]
+
+	var routers []map[string]interface{}
+	for _, r := range zone.Routers {
+		routers = append(routers, map[string]interface{}{
+			"router_id":     r.RouterID,
+			"router_region": r.RouterRegion,
+		})
+	}
+
+	log.Printf("[INFO] Retrieved DNS Zone using given filter %s: %+v", zone.ID, zone)
+	d.SetId(zone.ID)
+
+	d.Set("name", zone.Name)
+	d.Set("zone_type", zone.ZoneType)
+	d.Set("email", zone.Email)
+	d.Set("description", zone.Description)
+	d.Set("ttl", zone.TTL)
+	d.Set("status", zone.Status)
+	d.Set("region", GetRegion(d, config))
+	if err := d.Set("masters", zone.Masters); err != nil {
+		return err
+	}
+	if err := d.Set("router", routers); err != nil {
+		return err
+	}
+
+	return nil
+}
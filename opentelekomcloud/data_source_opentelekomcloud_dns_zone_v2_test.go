@@ -0,0 +1,71 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDNSV2ZoneDataSource_basic(t *testing.T) {
+	var zoneName = fmt.Sprintf("acpttest%s.com.", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckDNS(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDNSV2ZoneDataSource_basic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2ZoneDataSourceID("data.opentelekomcloud_dns_zone_v2.zone_1"),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_dns_zone_v2.zone_1", "name", zoneName),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_dns_zone_v2.zone_1", "zone_type", "private"),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_dns_zone_v2.zone_1", "description", "a zone"),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_dns_zone_v2.zone_1", "router.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDNSV2ZoneDataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find DNS zone data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("DNS zone data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+func testAccDNSV2ZoneDataSource_basic(zoneName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_dns_zone_v2" "zone_1" {
+  name = "%s"
+  email = "email1@example.com"
+  description = "a zone"
+  ttl = 3000
+  type = "private"
+  router = {
+    router_id = "%s"
+    router_region = "%s"
+  }
+}
+
+data "opentelekomcloud_dns_zone_v2" "zone_1" {
+  name = "${opentelekomcloud_dns_zone_v2.zone_1.name}"
+  zone_type = "private"
+}
+`, zoneName, OS_VPC_ID, OS_REGION_NAME)
+}
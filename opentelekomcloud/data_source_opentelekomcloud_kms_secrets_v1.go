@@ -0,0 +1,75 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk/openstack/kms/v1/keys"
+)
+
+func dataSourceKmsSecretsV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKmsSecretsV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"secret": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"cipher_text": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"encryption_context": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"plain_text": &schema.Schema{
+				Type:      schema.TypeMap,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceKmsSecretsV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmsKeyV1Client, err := config.kmsKeyV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud kms key client: %s", err)
+	}
+
+	plainText := make(map[string]string)
+	for _, s := range d.Get("secret").(*schema.Set).List() {
+		secret := s.(map[string]interface{})
+		name := secret["name"].(string)
+
+		decryptOpts := &keys.DecryptDataOpts{
+			CipherText:        secret["cipher_text"].(string),
+			EncryptionContext: secret["encryption_context"].(string),
+		}
+
+		log.Printf("[DEBUG] KMS decrypt secret: %s", name)
+		v, err := keys.DecryptData(kmsKeyV1Client, decryptOpts).ExtractDecryptedData()
+		if err != nil {
+			return fmt.Errorf("Error decrypting secret %s: %s", name, err)
+		}
+		plainText[name] = v.PlainText
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("plain_text", plainText)
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccKmsSecretsV1DataSource_basic(t *testing.T) {
+	var keyAlias = fmt.Sprintf("kms_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKmsV1KeyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKmsV1Ciphertext_basic(keyAlias),
+			},
+			resource.TestStep{
+				Config: testAccKmsSecretsV1DataSource_basic(keyAlias),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_kms_secrets_v1.secrets", "plain_text.%", "1"),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_kms_secrets_v1.secrets", "plain_text.db_password", "Super secret data"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKmsSecretsV1DataSource_basic(keyAlias string) string {
+	return fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_kms_secrets_v1" "secrets" {
+  secret {
+    name        = "db_password"
+    cipher_text = "${opentelekomcloud_kms_ciphertext_v1.ciphertext_1.cipher_text}"
+  }
+}
+`, testAccKmsV1Ciphertext_basic(keyAlias))
+}
@@ -0,0 +1,120 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/certificates"
+)
+
+func dataSourceCertificateV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCertificateV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"server", "client"})
+				},
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expire_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCertificateV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.hwNetworkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	listOpts := certificates.ListOpts{
+		ID:     d.Get("id").(string),
+		Name:   d.Get("name").(string),
+		Domain: d.Get("domain").(string),
+		Type:   certificates.Type(d.Get("type").(string)),
+	}
+
+	pages, err := certificates.List(networkingClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve certificates: %s", err)
+	}
+
+	allCertificates, err := certificates.ExtractCertificates(pages)
+	if err != nil {
+		return fmt.Errorf("Unable to extract certificates: %s", err)
+	}
+
+	if len(allCertificates) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allCertificates) > 1 {
+		return fmt.Errorf("Your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+
+	c := allCertificates[0]
+
+	log.Printf("[DEBUG] Retrieved certificate %s: %s", c.ID, c.Name)
+	d.SetId(c.ID)
+
+	d.Set("name", c.Name)
+	d.Set("domain", c.Domain)
+	d.Set("type", c.Type)
+	d.Set("description", c.Description)
+	d.Set("certificate", c.Certificate)
+	d.Set("expire_time", c.ExpireTime)
+	d.Set("create_time", c.CreateTime)
+	d.Set("update_time", c.UpdateTime)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
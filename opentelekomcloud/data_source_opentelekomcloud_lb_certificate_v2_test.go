@@ -0,0 +1,54 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccLBV2CertificateDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLBV2CertificateConfig_basic,
+			},
+			resource.TestStep{
+				Config: testAccLBV2CertificateDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2CertificateDataSourceID("data.opentelekomcloud_lb_certificate_v2.certificate_1"),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_lb_certificate_v2.certificate_1", "domain", "www.example.com"),
+					resource.TestCheckResourceAttrSet(
+						"data.opentelekomcloud_lb_certificate_v2.certificate_1", "expire_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBV2CertificateDataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find certificate data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Certificate data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+var testAccLBV2CertificateDataSource_basic = fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_lb_certificate_v2" "certificate_1" {
+  name = "${opentelekomcloud_lb_certificate_v2.certificate_1.name}"
+}
+`, testAccLBV2CertificateConfig_basic)
@@ -0,0 +1,63 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk/openstack/rts/v1/stackresources"
+)
+
+func dataSourceRTSResourceTypesV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRTSResourceTypesV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"prefix": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_types": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceRTSResourceTypesV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	orchestrationClient, err := config.orchestrationV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating RTS Client: %s", err)
+	}
+
+	allTypes, err := stackresources.ListTypes(orchestrationClient).Extract()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve RTS resource types: %s", err)
+	}
+
+	prefix := d.Get("prefix").(string)
+	resourceTypes := make([]string, 0, len(allTypes))
+	for _, t := range allTypes {
+		if strings.HasPrefix(t, prefix) {
+			resourceTypes = append(resourceTypes, t)
+		}
+	}
+	sort.Strings(resourceTypes)
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(strings.Join(resourceTypes, ","))))
+	d.Set("resource_types", resourceTypes)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package opentelekomcloud
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOTCRTSResourceTypesV1DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOTCRTSResourceTypesV1DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOTCRTSStackV1DataSourceID("data.opentelekomcloud_rts_resource_types_v1.heat"),
+					resource.TestMatchResourceAttr("data.opentelekomcloud_rts_resource_types_v1.heat", "resource_types.#",
+						regexp.MustCompile("^[1-9][0-9]*$")),
+					resource.TestMatchResourceAttr("data.opentelekomcloud_rts_resource_types_v1.heat", "resource_types.0",
+						regexp.MustCompile("^OS::Heat::")),
+				),
+			},
+		},
+	})
+}
+
+const testAccOTCRTSResourceTypesV1DataSource_basic = `
+data "opentelekomcloud_rts_resource_types_v1" "heat" {
+  prefix = "OS::Heat::"
+}
+`
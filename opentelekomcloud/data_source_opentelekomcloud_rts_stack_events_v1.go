@@ -0,0 +1,127 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk/openstack/rts/v1/stackevents"
+	"github.com/huaweicloud/golangsdk/openstack/rts/v1/stacks"
+)
+
+func dataSourceRTSStackEventsV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRTSStackEventsV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"stack_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_action": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"events": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_status_reason": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"logical_resource_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"physical_resource_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRTSStackEventsV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	orchestrationClient, err := config.orchestrationV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating RTS Client: %s", err)
+	}
+
+	stackName := d.Get("stack_name").(string)
+	stack, err := stacks.Get(orchestrationClient, stackName).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving Stack: %s", err)
+	}
+
+	listOpts := stackevents.ListOpts{
+		ResourceName:   d.Get("resource_name").(string),
+		ResourceStatus: d.Get("resource_status").(string),
+		ResourceAction: d.Get("resource_action").(string),
+	}
+	events, err := stackevents.List(orchestrationClient, stack.Name, stack.ID, listOpts)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve Stack Events: %s", err)
+	}
+	log.Printf("[DEBUG] Retrieved %d events of Stack %s", len(events), stackName)
+
+	d.SetId(stack.ID)
+	if err := d.Set("events", flattenStackEvents(events)); err != nil {
+		return err
+	}
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func flattenStackEvents(events []stackevents.Event) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(events))
+	for _, event := range events {
+		result = append(result, map[string]interface{}{
+			"id":                     event.ID,
+			"resource_name":          event.ResourceName,
+			"resource_status":        event.ResourceStatus,
+			"resource_status_reason": event.ResourceStatusReason,
+			"logical_resource_id":    event.LogicalResourceID,
+			"physical_resource_id":   event.PhysicalResourceID,
+			"event_time":             event.Time.Format(time.RFC3339),
+		})
+	}
+	return result
+}
@@ -0,0 +1,37 @@
+package opentelekomcloud
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOTCRTSStackEventsV1DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOTCRTSStackV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOTCRTSStackEventsV1DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOTCRTSStackV1DataSourceID("data.opentelekomcloud_rts_stack_events_v1.events"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_rts_stack_events_v1.events", "events.#", "1"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_rts_stack_events_v1.events", "events.0.resource_name", "random"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_rts_stack_events_v1.events", "events.0.resource_status", "CREATE_COMPLETE"),
+					resource.TestMatchResourceAttr("data.opentelekomcloud_rts_stack_events_v1.events", "events.0.event_time",
+						regexp.MustCompile("^[0-9]{4}-[0-9]{2}-[0-9]{2}T")),
+				),
+			},
+		},
+	})
+}
+
+var testAccOTCRTSStackEventsV1DataSource_basic = testAccRTSStackV1_basic + `
+data "opentelekomcloud_rts_stack_events_v1" "events" {
+  stack_name = "${opentelekomcloud_rts_stack_v1.stack_1.name}"
+  resource_name = "random"
+  resource_status = "COMPLETE"
+}
+`
@@ -0,0 +1,121 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk/openstack/rts/v1/stacks"
+)
+
+func dataSourceRTSStackPreviewV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRTSStackPreviewV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"template_body": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateStackTemplate,
+			},
+			"template_url": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"files": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"environment": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateJsonString,
+			},
+			"parameters": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"timeout_mins": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"disable_rollback": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"added": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"deleted": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"replaced": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"updated": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"unchanged": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceRTSStackPreviewV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	orchestrationClient, err := config.orchestrationV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating RTS Client: %s", err)
+	}
+
+	stackName := d.Get("name").(string)
+	stack, err := stacks.Get(orchestrationClient, stackName).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving Stack: %s", err)
+	}
+
+	changes, err := stacks.PreviewUpdate(orchestrationClient, stackName, stack.ID, resourceRTSStackV1UpdateOpts(d)).Extract()
+	if err != nil {
+		return fmt.Errorf("Error previewing update of Stack %s: %s", stackName, err)
+	}
+	log.Printf("[DEBUG] Retrieved update preview of Stack %s: %+v", stackName, changes)
+
+	d.SetId(stack.ID)
+	d.Set("added", flattenStackPreviewedResources(changes.Added))
+	d.Set("deleted", flattenStackPreviewedResources(changes.Deleted))
+	d.Set("replaced", flattenStackPreviewedResources(changes.Replaced))
+	d.Set("updated", flattenStackPreviewedResources(changes.Updated))
+	d.Set("unchanged", flattenStackPreviewedResources(changes.Unchanged))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func flattenStackPreviewedResources(resources []stacks.PreviewedResource) []string {
+	names := make([]string, 0, len(resources))
+	for _, r := range resources {
+		names = append(names, r.Name)
+	}
+	return names
+}
@@ -0,0 +1,51 @@
+package opentelekomcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOTCRTSStackPreviewV1DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOTCRTSStackV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccRTSStackV1_basic,
+			},
+			resource.TestStep{
+				Config: testAccOTCRTSStackPreviewV1DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOTCRTSStackV1DataSourceID("data.opentelekomcloud_rts_stack_preview_v1.preview"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_rts_stack_preview_v1.preview", "replaced.#", "1"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_rts_stack_preview_v1.preview", "replaced.0", "random"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_rts_stack_preview_v1.preview", "added.#", "0"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_rts_stack_preview_v1.preview", "deleted.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+var testAccOTCRTSStackPreviewV1DataSource_basic = testAccRTSStackV1_basic + `
+data "opentelekomcloud_rts_stack_preview_v1" "preview" {
+  name = "${opentelekomcloud_rts_stack_v1.stack_1.name}"
+  disable_rollback = true
+  timeout_mins = 60
+  template_body = <<JSON
+  {
+    "heat_template_version": "2013-05-23",
+    "resources": {
+      "random": {
+        "type": "OS::Heat::RandomString",
+        "properties": {
+          "length": 8
+        }
+      }
+    }
+  }
+JSON
+}
+`
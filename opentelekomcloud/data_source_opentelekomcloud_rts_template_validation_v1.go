@@ -0,0 +1,151 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/rts/v1/stacktemplates"
+)
+
+func dataSourceRTSTemplateValidationV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRTSTemplateValidationV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"template_body": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validateStackTemplate,
+				ConflictsWith: []string{"template_url"},
+			},
+			"template_url": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"template_body"},
+			},
+			"environment": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateJsonString,
+			},
+			"files": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parameters": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"label": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRTSTemplateValidationV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	orchestrationClient, err := config.orchestrationV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating RTS Client: %s", err)
+	}
+
+	validateOpts := stacktemplates.ValidateOpts{
+		Template:    d.Get("template_body").(string),
+		TemplateURL: d.Get("template_url").(string),
+		Environment: d.Get("environment").(string),
+		Files:       resourceFilesV1(d),
+	}
+	if validateOpts.Template == "" && validateOpts.TemplateURL == "" {
+		return fmt.Errorf("One of template_body or template_url must be set")
+	}
+
+	validated, err := validateRTSTemplateV1(orchestrationClient, validateOpts)
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] Validated RTS template: %+v", validated)
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(validateOpts.Template+validateOpts.TemplateURL)))
+	d.Set("description", validated.Description)
+	if err := d.Set("parameters", flattenTemplateParameters(validated.Parameters)); err != nil {
+		return err
+	}
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+// validateRTSTemplateV1 validates a template with the RTS service. The body
+// of a 400 response, which names the faulty part of the template, is returned
+// as the error.
+func validateRTSTemplateV1(client *golangsdk.ServiceClient, opts stacktemplates.ValidateOpts) (*stacktemplates.ValidatedTemplate, error) {
+	validated, err := stacktemplates.Validate(client, opts).Extract()
+	if err != nil {
+		if e, ok := err.(golangsdk.ErrDefault400); ok {
+			return nil, fmt.Errorf("Invalid RTS template: %s", e.Body)
+		}
+		return nil, fmt.Errorf("Error validating RTS template: %s", err)
+	}
+	return validated, nil
+}
+
+func flattenTemplateParameters(parameters map[string]stacktemplates.TemplateParameter) []map[string]interface{} {
+	names := make([]string, 0, len(parameters))
+	for name := range parameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make([]map[string]interface{}, 0, len(parameters))
+	for _, name := range names {
+		p := parameters[name]
+		def := ""
+		if p.Default != nil {
+			def = fmt.Sprintf("%v", p.Default)
+		}
+		result = append(result, map[string]interface{}{
+			"name":        name,
+			"type":        p.Type,
+			"description": p.Description,
+			"label":       p.Label,
+			"default":     def,
+		})
+	}
+	return result
+}
@@ -0,0 +1,116 @@
+package opentelekomcloud
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/huaweicloud/golangsdk/openstack/rts/v1/stacktemplates"
+)
+
+func TestFlattenTemplateParameters(t *testing.T) {
+	parameters := map[string]stacktemplates.TemplateParameter{
+		"length": {
+			Type:    "Number",
+			Default: 6.0,
+		},
+		"image_id": {
+			Type:        "String",
+			Description: "Image to be used for compute instance",
+			Label:       "Image ID",
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"name":        "image_id",
+			"type":        "String",
+			"description": "Image to be used for compute instance",
+			"label":       "Image ID",
+			"default":     "",
+		},
+		{
+			"name":        "length",
+			"type":        "Number",
+			"description": "",
+			"label":       "",
+			"default":     "6",
+		},
+	}
+
+	if got := flattenTemplateParameters(parameters); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Expected:\n%#v\ngot:\n%#v", expected, got)
+	}
+}
+
+func TestAccOTCRTSTemplateValidationV1DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOTCRTSTemplateValidationV1DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.opentelekomcloud_rts_template_validation_v1.template", "description", "A random string"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_rts_template_validation_v1.template", "parameters.#", "1"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_rts_template_validation_v1.template", "parameters.0.name", "length"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_rts_template_validation_v1.template", "parameters.0.default", "6"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOTCRTSTemplateValidationV1DataSource_invalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccOTCRTSTemplateValidationV1DataSource_invalid,
+				ExpectError: regexp.MustCompile("Invalid RTS template"),
+			},
+		},
+	})
+}
+
+const testAccOTCRTSTemplateValidationV1DataSource_basic = `
+data "opentelekomcloud_rts_template_validation_v1" "template" {
+  template_body = <<JSON
+  {
+    "heat_template_version": "2013-05-23",
+    "description": "A random string",
+    "parameters": {
+      "length": {
+        "type": "number",
+        "default": 6
+      }
+    },
+    "resources": {
+      "random": {
+        "type": "OS::Heat::RandomString",
+        "properties": {
+          "length": { "get_param": "length" }
+        }
+      }
+    }
+  }
+JSON
+}
+`
+
+const testAccOTCRTSTemplateValidationV1DataSource_invalid = `
+data "opentelekomcloud_rts_template_validation_v1" "template" {
+  template_body = <<JSON
+  {
+    "heat_template_version": "2013-05-23",
+    "resources": {
+      "random": {
+        "type": "OS::Heat::NoSuchResourceType"
+      }
+    }
+  }
+JSON
+}
+`
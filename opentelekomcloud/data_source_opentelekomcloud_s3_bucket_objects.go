@@ -0,0 +1,113 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceS3BucketObjects() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceS3BucketObjectsRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"prefix": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"delimiter": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"max_keys": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"keys": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"etags": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"sizes": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+			"common_prefixes": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceS3BucketObjectsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	s3conn, err := config.computeS3conn(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud s3 client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+	maxKeys := d.Get("max_keys").(int)
+
+	input := &s3.ListObjectsInput{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	if v, ok := d.GetOk("delimiter"); ok {
+		input.Delimiter = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Reading S3 bucket objects: %s", input)
+	var keys, etags, commonPrefixes []string
+	var sizes []int
+	err = s3conn.ListObjectsPages(input, func(page *s3.ListObjectsOutput, lastPage bool) bool {
+		for _, p := range page.CommonPrefixes {
+			if len(keys)+len(commonPrefixes) >= maxKeys {
+				return false
+			}
+			commonPrefixes = append(commonPrefixes, aws.StringValue(p.Prefix))
+		}
+		for _, o := range page.Contents {
+			if len(keys)+len(commonPrefixes) >= maxKeys {
+				return false
+			}
+			keys = append(keys, aws.StringValue(o.Key))
+			etags = append(etags, strings.Trim(aws.StringValue(o.ETag), `"`))
+			sizes = append(sizes, int(aws.Int64Value(o.Size)))
+		}
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing objects of S3 bucket %s: %s", bucket, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", bucket, prefix))
+	d.Set("keys", keys)
+	d.Set("etags", etags)
+	d.Set("sizes", sizes)
+	d.Set("common_prefixes", commonPrefixes)
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceS3BucketObjects_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourcesConf, conf := testAccDataSourceS3ObjectsConfig_basic(rInt)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                  func() { testAccPreCheck(t) },
+		Providers:                 testAccProviders,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: resourcesConf,
+			},
+			resource.TestStep{
+				Config: conf,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.opentelekomcloud_s3_bucket_objects.all", "keys.#", "3"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_s3_bucket_objects.all", "keys.0", "arch/navajo/north_window"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_s3_bucket_objects.all", "sizes.0", "11"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_s3_bucket_objects.all", "etags.0", "b10a8db164e0754105b7a99be72e3fe5"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_s3_bucket_objects.delimited", "keys.#", "0"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_s3_bucket_objects.delimited", "common_prefixes.#", "2"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_s3_bucket_objects.delimited", "common_prefixes.0", "arch/navajo/"),
+					resource.TestCheckResourceAttr("data.opentelekomcloud_s3_bucket_objects.limited", "keys.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceS3ObjectsConfig_basic(randInt int) (string, string) {
+	resources := fmt.Sprintf(`
+resource "opentelekomcloud_s3_bucket" "objects_bucket" {
+	bucket = "tf-objects-test-bucket-%d"
+}
+
+resource "opentelekomcloud_s3_bucket_object" "object1" {
+	bucket = "${opentelekomcloud_s3_bucket.objects_bucket.bucket}"
+	key = "arch/navajo/north_window"
+	content = "Hello World"
+}
+
+resource "opentelekomcloud_s3_bucket_object" "object2" {
+	bucket = "${opentelekomcloud_s3_bucket.objects_bucket.bucket}"
+	key = "arch/navajo/sand_dune"
+	content = "Hello World"
+}
+
+resource "opentelekomcloud_s3_bucket_object" "object3" {
+	bucket = "${opentelekomcloud_s3_bucket.objects_bucket.bucket}"
+	key = "arch/rubicon"
+	content = "Hello World"
+}
+`, randInt)
+
+	both := fmt.Sprintf(`%s
+data "opentelekomcloud_s3_bucket_objects" "all" {
+	bucket = "tf-objects-test-bucket-%d"
+	prefix = "arch/"
+}
+
+data "opentelekomcloud_s3_bucket_objects" "delimited" {
+	bucket = "tf-objects-test-bucket-%d"
+	prefix = "arch/"
+	delimiter = "/"
+}
+
+data "opentelekomcloud_s3_bucket_objects" "limited" {
+	bucket = "tf-objects-test-bucket-%d"
+	prefix = "arch/"
+	max_keys = 1
+}
+`, resources, randInt, randInt, randInt)
+
+	return resources, both
+}
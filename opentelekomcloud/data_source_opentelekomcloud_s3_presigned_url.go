@@ -0,0 +1,130 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// A signature version 4 presigned URL is valid for at most 7 days.
+const s3PresignMaxExpiry = 7 * 24 * 60 * 60
+
+func dataSourceS3PresignedURL() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceS3PresignedURLRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"key": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"method": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "GET",
+				ValidateFunc: validation.StringInSlice([]string{"GET", "PUT"}, false),
+			},
+			"expires_in": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      900,
+				ValidateFunc: validation.IntBetween(1, s3PresignMaxExpiry),
+			},
+			"version_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"content_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"url": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expiration": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceS3PresignedURLRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	s3conn, err := config.computeS3conn(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud s3 client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	method := d.Get("method").(string)
+
+	if _, ok := d.GetOk("version_id"); ok && method != "GET" {
+		return fmt.Errorf("version_id can only be set for GET URLs")
+	}
+	if _, ok := d.GetOk("content_type"); ok && method != "PUT" {
+		return fmt.Errorf("content_type can only be set for PUT URLs")
+	}
+
+	signTime := time.Now().UTC()
+	expiresIn := time.Duration(d.Get("expires_in").(int)) * time.Second
+
+	log.Printf("[DEBUG] Presigning %s of %s in S3 bucket %s, expires in %s", method, key, bucket, expiresIn)
+	url, err := s3PresignedURL(s3conn, d, signTime, expiresIn)
+	if err != nil {
+		return fmt.Errorf("Error presigning %s of %s in S3 bucket %s: %s", method, key, bucket, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", bucket, key))
+	d.Set("url", url)
+	d.Set("expiration", signTime.Add(expiresIn).Format(time.RFC3339))
+
+	return nil
+}
+
+// s3PresignedURL signs the request described by d at signTime, so that the
+// URL can be used without credentials until signTime+expiresIn.
+func s3PresignedURL(s3conn *s3.S3, d *schema.ResourceData, signTime time.Time, expiresIn time.Duration) (string, error) {
+	bucket := aws.String(d.Get("bucket").(string))
+	key := aws.String(d.Get("key").(string))
+
+	var req *request.Request
+	switch d.Get("method").(string) {
+	case "PUT":
+		input := &s3.PutObjectInput{
+			Bucket: bucket,
+			Key:    key,
+		}
+		// The content type is signed, uploads must send the same Content-Type.
+		if v, ok := d.GetOk("content_type"); ok {
+			input.ContentType = aws.String(v.(string))
+		}
+		req, _ = s3conn.PutObjectRequest(input)
+	default:
+		input := &s3.GetObjectInput{
+			Bucket: bucket,
+			Key:    key,
+		}
+		if v, ok := d.GetOk("version_id"); ok {
+			input.VersionId = aws.String(v.(string))
+		}
+		req, _ = s3conn.GetObjectRequest(input)
+	}
+
+	// The signer signs requests at the time they were created.
+	req.Time = signTime
+	return req.Presign(expiresIn)
+}
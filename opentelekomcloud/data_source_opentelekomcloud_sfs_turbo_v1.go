@@ -0,0 +1,153 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk/openstack/sfs_turbo/v1/shares"
+)
+
+func dataSourceSFSTurboV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSFSTurboV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"share_proto": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"share_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnet_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"crypt_key_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"export_location": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"available_capacity": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSFSTurboV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.sfsTurboV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud SFS Turbo client: %s", err)
+	}
+
+	pages, err := shares.List(client).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve SFS Turbos: %s", err)
+	}
+
+	allShares, err := shares.ExtractShares(pages)
+	if err != nil {
+		return fmt.Errorf("Unable to extract SFS Turbos: %s", err)
+	}
+
+	id := d.Get("id").(string)
+	name := d.Get("name").(string)
+	var refinedShares []shares.Turbo
+	for _, share := range allShares {
+		if id != "" && share.ID != id {
+			continue
+		}
+		if name != "" && share.Name != name {
+			continue
+		}
+		refinedShares = append(refinedShares, share)
+	}
+
+	if len(refinedShares) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(refinedShares) > 1 {
+		return fmt.Errorf("Your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+
+	share := refinedShares[0]
+
+	log.Printf("[INFO] Retrieved SFS Turbo using given filter %s: %+v", share.ID, share)
+	d.SetId(share.ID)
+
+	d.Set("name", share.Name)
+	d.Set("share_proto", share.ShareProto)
+	d.Set("share_type", share.ShareType)
+	d.Set("availability_zone", share.AvailabilityZone)
+	d.Set("vpc_id", share.VpcID)
+	d.Set("subnet_id", share.SubnetID)
+	d.Set("security_group_id", share.SecurityGroupID)
+	d.Set("crypt_key_id", share.CryptKeyID)
+	d.Set("description", share.Description)
+	d.Set("status", share.Status)
+	d.Set("export_location", share.ExportLocation)
+	d.Set("available_capacity", share.AvailCapacity)
+	d.Set("version", share.Version)
+	d.Set("region", GetRegion(d, config))
+
+	if size, err := strconv.ParseFloat(share.Size, 64); err == nil {
+		d.Set("size", int(size))
+	}
+
+	return nil
+}
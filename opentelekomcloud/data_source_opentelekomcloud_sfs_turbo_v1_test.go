@@ -0,0 +1,42 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOTCSFSTurboV1DataSource_basic(t *testing.T) {
+	name := fmt.Sprintf("sfs-turbo-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSFSTurboV1DataSource_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.opentelekomcloud_sfs_turbo_v1.sfs_turbo_1", "id",
+						"opentelekomcloud_sfs_turbo_v1.sfs_turbo_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_sfs_turbo_v1.sfs_turbo_1", "size", "500"),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_sfs_turbo_v1.sfs_turbo_1", "share_type", "STANDARD"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSFSTurboV1DataSource_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "opentelekomcloud_sfs_turbo_v1" "sfs_turbo_1" {
+  name = "${opentelekomcloud_sfs_turbo_v1.sfs_turbo_1.name}"
+}
+`, testAccSFSTurboV1_basic(name, 500))
+}
@@ -0,0 +1,100 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/smn/v2/topics"
+)
+
+func dataSourceSmnTopicV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSmnTopicV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"topic_urn": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"display_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"push_policy": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"update_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSmnTopicV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.SmnV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud smn client: %s", err)
+	}
+
+	topicsList, err := topics.List(client).Extract()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve topics: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	topicUrn := d.Get("topic_urn").(string)
+	displayName := d.Get("display_name").(string)
+
+	var refinedTopics []topics.TopicGet
+	for _, topic := range topicsList {
+		if name != "" && topic.Name != name {
+			continue
+		}
+		if topicUrn != "" && topic.TopicUrn != topicUrn {
+			continue
+		}
+		if displayName != "" && topic.DisplayName != displayName {
+			continue
+		}
+		refinedTopics = append(refinedTopics, topic)
+	}
+
+	if len(refinedTopics) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(refinedTopics) > 1 {
+		return fmt.Errorf("Your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+
+	topic := refinedTopics[0]
+
+	log.Printf("[INFO] Retrieved topic using given filter %s: %+v", topic.TopicUrn, topic)
+	d.SetId(topic.TopicUrn)
+
+	d.Set("name", topic.Name)
+	d.Set("topic_urn", topic.TopicUrn)
+	d.Set("display_name", topic.DisplayName)
+	d.Set("push_policy", topic.PushPolicy)
+	d.Set("update_time", topic.UpdateTime)
+	d.Set("create_time", topic.CreateTime)
+
+	return nil
+}
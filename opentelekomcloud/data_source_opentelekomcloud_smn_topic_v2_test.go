@@ -0,0 +1,55 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccSMNV2TopicDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSMNV2TopicDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSMNV2TopicDataSourceID("data.opentelekomcloud_smn_topic_v2.topic_1"),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_smn_topic_v2.topic_1", "name", "topic_1"),
+					resource.TestCheckResourceAttr(
+						"data.opentelekomcloud_smn_topic_v2.topic_1", "display_name",
+						"The display name of topic_1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSMNV2TopicDataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find topic data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Topic data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+var testAccSMNV2TopicDataSource_basic = `
+resource "opentelekomcloud_smn_topic_v2" "topic_1" {
+  name         = "topic_1"
+  display_name = "The display name of topic_1"
+}
+
+data "opentelekomcloud_smn_topic_v2" "topic_1" {
+  name = "${opentelekomcloud_smn_topic_v2.topic_1.name}"
+}
+`
@@ -118,3 +118,12 @@ func suppressAutoscalingGroupAvailabilityZoneDiffs(k, old, new string, d *schema
 
 	return false
 }
+
+// Suppress changes between PEM encoded strings that only differ in line endings
+// or surrounding whitespace, the API normalizes certificates and keys on upload.
+func suppressEquivalentPEMDiffs(k, old, new string, d *schema.ResourceData) bool {
+	normalize := func(s string) string {
+		return strings.TrimSpace(strings.Replace(s, "\r\n", "\n", -1))
+	}
+	return normalize(old) == normalize(new)
+}
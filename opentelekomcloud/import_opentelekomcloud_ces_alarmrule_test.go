@@ -0,0 +1,28 @@
+package opentelekomcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestCESAlarmRule_importBasic(t *testing.T) {
+	resourceName := "opentelekomcloud_ces_alarmrule.alarmrule_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCESAlarmRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testCESAlarmRule_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
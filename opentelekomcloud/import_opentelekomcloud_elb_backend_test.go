@@ -0,0 +1,48 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccELBBackend_importBasic(t *testing.T) {
+	resourceName := "opentelekomcloud_elb_backend.backend_1"
+
+	steps := []resource.TestStep{
+		resource.TestStep{
+			Config: TestAccELBBackendConfig_basic,
+		},
+
+		resource.TestStep{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	// Backend members are imported as <listener_id>/<member_id>.
+	steps[0].Check = testAccELBBackendImportIDPrefix(resourceName, &steps[1])
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckELBBackendDestroy,
+		Steps:        steps,
+	})
+}
+
+// testAccELBBackendImportIDPrefix sets the listener ID of the backend member
+// as the prefix of the ID imported by step.
+func testAccELBBackendImportIDPrefix(n string, step *resource.TestStep) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		step.ImportStateIdPrefix = fmt.Sprintf("%s/", rs.Primary.Attributes["listener_id"])
+		return nil
+	}
+}
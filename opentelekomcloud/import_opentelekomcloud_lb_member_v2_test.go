@@ -0,0 +1,48 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccLBV2Member_importBasic(t *testing.T) {
+	resourceName := "opentelekomcloud_lb_member_v2.member_1"
+
+	steps := []resource.TestStep{
+		resource.TestStep{
+			Config: TestAccLBV2MemberConfig_basic,
+		},
+
+		resource.TestStep{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	// Members are imported as <pool_id>/<member_id>.
+	steps[0].Check = testAccLBV2MemberImportIDPrefix(resourceName, &steps[1])
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2MemberDestroy,
+		Steps:        steps,
+	})
+}
+
+// testAccLBV2MemberImportIDPrefix sets the pool ID of the member as the prefix
+// of the ID imported by step.
+func testAccLBV2MemberImportIDPrefix(n string, step *resource.TestStep) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		step.ImportStateIdPrefix = fmt.Sprintf("%s/", rs.Primary.Attributes["pool_id"])
+		return nil
+	}
+}
@@ -1,9 +1,11 @@
 package opentelekomcloud
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestAccOTCSFSFileSystemV2_importBasic(t *testing.T) {
@@ -18,11 +20,54 @@ func TestAccOTCSFSFileSystemV2_importBasic(t *testing.T) {
 				Config: testAccSFSFileSystemV2_basic,
 			},
 
+			// A plain share ID leaves the inline access rule empty.
 			resource.TestStep{
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"share_access_id", "access_rule_status", "access_to", "access_type", "access_level",
+				},
 			},
 		},
 	})
 }
+
+func TestAccOTCSFSFileSystemV2_importAccessRule(t *testing.T) {
+	resourceName := "opentelekomcloud_sfs_file_system_v2.sfs_1"
+
+	steps := []resource.TestStep{
+		resource.TestStep{
+			Config: testAccSFSFileSystemV2_basic,
+		},
+
+		resource.TestStep{
+			ResourceName:      resourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	}
+	// The access rule is adopted with an ID of <share_id>/<access_id>.
+	steps[0].Check = testAccSFSFileSystemV2ImportID(resourceName, &steps[1])
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSFSFileSystemV2Destroy,
+		Steps:        steps,
+	})
+}
+
+// testAccSFSFileSystemV2ImportID sets the share and access rule IDs of the
+// share as the ID imported by step.
+func testAccSFSFileSystemV2ImportID(n string, step *resource.TestStep) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		step.ImportStateId = fmt.Sprintf("%s/%s", rs.Primary.ID, rs.Primary.Attributes["share_access_id"])
+		return nil
+	}
+}
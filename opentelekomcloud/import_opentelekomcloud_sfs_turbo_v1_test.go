@@ -0,0 +1,31 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOTCSFSTurboV1_importBasic(t *testing.T) {
+	resourceName := "opentelekomcloud_sfs_turbo_v1.sfs_turbo_1"
+	name := fmt.Sprintf("sfs-turbo-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSFSTurboV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSFSTurboV1_basic(name, 500),
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
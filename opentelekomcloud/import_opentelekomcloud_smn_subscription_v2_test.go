@@ -0,0 +1,28 @@
+package opentelekomcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccSMNV2Subscription_importBasic(t *testing.T) {
+	resourceName := "opentelekomcloud_smn_subscription_v2.subscription_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSMNSubscriptionV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: TestAccSMNV2SubscriptionConfig_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
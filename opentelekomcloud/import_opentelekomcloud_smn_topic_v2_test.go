@@ -0,0 +1,28 @@
+package opentelekomcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccSMNV2Topic_importBasic(t *testing.T) {
+	resourceName := "opentelekomcloud_smn_topic_v2.topic_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSMNTopicV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: TestAccSMNV2TopicConfig_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
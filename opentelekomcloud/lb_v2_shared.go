@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/l7policies"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/listeners"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/loadbalancers"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/monitors"
@@ -241,3 +242,98 @@ func waitForLBV2viaPool(networkingClient *gophercloud.ServiceClient, id string,
 	// got a pool but no LB - this is wrong
 	return fmt.Errorf("No Load Balancer on pool %s", id)
 }
+
+func waitForLBV2viaListener(networkingClient *gophercloud.ServiceClient, id string, target string, timeout time.Duration) error {
+	listener, err := listeners.Get(networkingClient, id).Extract()
+	if err != nil {
+		return err
+	}
+
+	if listener.Loadbalancers != nil {
+		lbID := listener.Loadbalancers[0].ID
+		return waitForLBV2LoadBalancer(networkingClient, lbID, target, nil, timeout)
+	}
+
+	// got a listener but no LB - this is wrong
+	return fmt.Errorf("No Load Balancer on listener %s", id)
+}
+
+func waitForLBV2L7Policy(networkingClient *gophercloud.ServiceClient, id string, target string, pending []string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for l7policy %s to become %s.", id, target)
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{target},
+		Pending:    pending,
+		Refresh:    resourceLBV2L7PolicyRefreshFunc(networkingClient, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 1 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			switch target {
+			case "DELETED":
+				return nil
+			default:
+				return fmt.Errorf("Error: l7policy %s not found: %s", id, err)
+			}
+		}
+		return fmt.Errorf("Error waiting for l7policy %s to become %s: %s", id, target, err)
+	}
+
+	return nil
+}
+
+func resourceLBV2L7PolicyRefreshFunc(networkingClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		l7policy, err := l7policies.Get(networkingClient, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		// The l7policy provisioning status is not reported by every backend, so a successful Get is the best we can do
+		return l7policy, "ACTIVE", nil
+	}
+}
+
+func waitForLBV2L7Rule(networkingClient *gophercloud.ServiceClient, l7policyID, l7ruleID string, target string, pending []string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for l7rule %s to become %s.", l7ruleID, target)
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{target},
+		Pending:    pending,
+		Refresh:    resourceLBV2L7RuleRefreshFunc(networkingClient, l7policyID, l7ruleID),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 1 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			switch target {
+			case "DELETED":
+				return nil
+			default:
+				return fmt.Errorf("Error: l7rule %s not found: %s", l7ruleID, err)
+			}
+		}
+		return fmt.Errorf("Error waiting for l7rule %s to become %s: %s", l7ruleID, target, err)
+	}
+
+	return nil
+}
+
+func resourceLBV2L7RuleRefreshFunc(networkingClient *gophercloud.ServiceClient, l7policyID, l7ruleID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		l7rule, err := l7policies.GetRule(networkingClient, l7policyID, l7ruleID).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		// The l7rule provisioning status is not reported by every backend, so a successful Get is the best we can do
+		return l7rule, "ACTIVE", nil
+	}
+}
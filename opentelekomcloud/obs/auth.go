@@ -0,0 +1,114 @@
+package obs
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// subResources are the query parameters which take part in the canonicalized
+// resource of the OBS signature.
+var subResources = map[string]bool{
+	"acl":                          true,
+	"delete":                       true,
+	"location":                     true,
+	"notification":                 true,
+	"partNumber":                   true,
+	"policy":                       true,
+	"quota":                        true,
+	"storageClass":                 true,
+	"storageinfo":                  true,
+	"tagging":                      true,
+	"uploadId":                     true,
+	"uploads":                      true,
+	"versionId":                    true,
+	"versioning":                   true,
+	"versions":                     true,
+	"website":                      true,
+	"encryption":                   true,
+	"replication":                  true,
+	"lifecycle":                    true,
+	"cors":                         true,
+	"logging":                      true,
+	"response-cache-control":       true,
+	"response-content-disposition": true,
+	"response-content-encoding":    true,
+	"response-content-language":    true,
+	"response-content-type":        true,
+	"response-expires":             true,
+}
+
+// stringToSign builds the string which is signed with the secret key.
+func stringToSign(method, bucket, key string, params map[string]string, header http.Header) string {
+	var buf bytes.Buffer
+
+	buf.WriteString(method)
+	buf.WriteString("\n")
+	buf.WriteString(header.Get(HeaderContentMD5))
+	buf.WriteString("\n")
+	buf.WriteString(header.Get(HeaderContentType))
+	buf.WriteString("\n")
+	if header.Get(HeaderDateObs) == "" {
+		buf.WriteString(header.Get(HeaderDate))
+	}
+	buf.WriteString("\n")
+
+	obsHeaders := make(map[string]string)
+	for k, v := range header {
+		lk := strings.ToLower(k)
+		if strings.HasPrefix(lk, HeaderPrefixObs) {
+			obsHeaders[lk] = strings.Join(v, ",")
+		}
+	}
+	obsKeys := make([]string, 0, len(obsHeaders))
+	for k := range obsHeaders {
+		obsKeys = append(obsKeys, k)
+	}
+	sort.Strings(obsKeys)
+	for _, k := range obsKeys {
+		buf.WriteString(k)
+		buf.WriteString(":")
+		buf.WriteString(strings.TrimSpace(obsHeaders[k]))
+		buf.WriteString("\n")
+	}
+
+	buf.WriteString("/")
+	if bucket != "" {
+		buf.WriteString(bucket)
+		buf.WriteString("/")
+		buf.WriteString(key)
+	}
+
+	resKeys := make([]string, 0, len(params))
+	for k := range params {
+		if subResources[k] {
+			resKeys = append(resKeys, k)
+		}
+	}
+	sort.Strings(resKeys)
+	for i, k := range resKeys {
+		if i == 0 {
+			buf.WriteString("?")
+		} else {
+			buf.WriteString("&")
+		}
+		buf.WriteString(k)
+		if v := params[k]; v != "" {
+			buf.WriteString("=")
+			buf.WriteString(v)
+		}
+	}
+
+	return buf.String()
+}
+
+// signature returns the base64 encoded HMAC-SHA1 of the string to sign.
+func signature(sk, stringToSign string) string {
+	h := hmac.New(sha1.New, []byte(sk))
+	h.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
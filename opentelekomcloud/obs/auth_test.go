@@ -0,0 +1,123 @@
+package obs
+
+import (
+	"net/http"
+	"testing"
+)
+
+// testSecretKey is the secret key of the examples of the "Signing and
+// Authenticating REST Requests" section of the Amazon S3 developer guide,
+// which uses the same signature with x-amz- instead of x-obs- headers.
+const testSecretKey = "wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY"
+
+func TestStringToSign(t *testing.T) {
+	cases := []struct {
+		name   string
+		method string
+		bucket string
+		key    string
+		params map[string]string
+		header http.Header
+		want   string
+	}{
+		{
+			name:   "object",
+			method: "GET",
+			bucket: "johnsmith",
+			key:    "photos/puppy.jpg",
+			header: http.Header{
+				"Date": {"Tue, 27 Mar 2007 19:36:42 +0000"},
+			},
+			want: "GET\n\n\nTue, 27 Mar 2007 19:36:42 +0000\n/johnsmith/photos/puppy.jpg",
+		},
+		{
+			name:   "content type",
+			method: "PUT",
+			bucket: "johnsmith",
+			key:    "photos/puppy.jpg",
+			header: http.Header{
+				"Content-Type":   {"image/jpeg"},
+				"Content-Length": {"94328"},
+				"Date":           {"Tue, 27 Mar 2007 21:15:45 +0000"},
+			},
+			want: "PUT\n\nimage/jpeg\nTue, 27 Mar 2007 21:15:45 +0000\n/johnsmith/photos/puppy.jpg",
+		},
+		{
+			name:   "sub-resources only",
+			method: "GET",
+			bucket: "johnsmith",
+			params: map[string]string{"acl": "", "prefix": "photos", "max-keys": "50"},
+			header: http.Header{
+				"Date": {"Tue, 27 Mar 2007 19:44:46 +0000"},
+			},
+			want: "GET\n\n\nTue, 27 Mar 2007 19:44:46 +0000\n/johnsmith/?acl",
+		},
+		{
+			name:   "sorted sub-resources with values",
+			method: "PUT",
+			bucket: "johnsmith",
+			key:    "big.iso",
+			params: map[string]string{"uploadId": "abc", "partNumber": "2"},
+			header: http.Header{
+				"Date": {"Tue, 27 Mar 2007 19:44:46 +0000"},
+			},
+			want: "PUT\n\n\nTue, 27 Mar 2007 19:44:46 +0000\n/johnsmith/big.iso?partNumber=2&uploadId=abc",
+		},
+		{
+			name:   "obs headers",
+			method: "DELETE",
+			bucket: "johnsmith",
+			key:    "photos/puppy.jpg",
+			header: http.Header{
+				"Date":                {"Tue, 27 Mar 2007 21:20:27 +0000"},
+				"X-Obs-Date":          {"Tue, 27 Mar 2007 21:20:26 +0000"},
+				"X-Obs-Meta-Reviewer": {" Alice ", "Bob"},
+				"User-Agent":          {"dotnet"},
+			},
+			want: "DELETE\n\n\n\n" +
+				"x-obs-date:Tue, 27 Mar 2007 21:20:26 +0000\n" +
+				"x-obs-meta-reviewer:Alice ,Bob\n" +
+				"/johnsmith/photos/puppy.jpg",
+		},
+		{
+			name:   "service",
+			method: "GET",
+			header: http.Header{
+				"Date": {"Wed, 28 Mar 2007 01:29:59 +0000"},
+			},
+			want: "GET\n\n\nWed, 28 Mar 2007 01:29:59 +0000\n/",
+		},
+	}
+
+	for _, tc := range cases {
+		if got := stringToSign(tc.method, tc.bucket, tc.key, tc.params, tc.header); got != tc.want {
+			t.Errorf("%s: expected string to sign %q, got %q", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestSignature(t *testing.T) {
+	cases := []struct {
+		stringToSign string
+		want         string
+	}{
+		{
+			"GET\n\n\nTue, 27 Mar 2007 19:36:42 +0000\n/johnsmith/photos/puppy.jpg",
+			"bWq2s1WEIj+Ydj0vQ697zp+IXMU=",
+		},
+		{
+			"PUT\n\nimage/jpeg\nTue, 27 Mar 2007 21:15:45 +0000\n/johnsmith/photos/puppy.jpg",
+			"MyyxeRY7whkBe+bq8fHCL/2kKUg=",
+		},
+		{
+			"GET\n\n\nTue, 27 Mar 2007 19:44:46 +0000\n/johnsmith/?acl",
+			"c2WLPFtWHVgbEmeEG93a4cG37dM=",
+		},
+	}
+
+	for _, tc := range cases {
+		if got := signature(testSecretKey, tc.stringToSign); got != tc.want {
+			t.Errorf("Expected signature %q of %q, got %q", tc.want, tc.stringToSign, got)
+		}
+	}
+}
@@ -0,0 +1,572 @@
+package obs
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ObsClient is a client for the OBS API.
+type ObsClient struct {
+	ak            string
+	sk            string
+	securityToken string
+	endpoint      *url.URL
+	httpClient    *http.Client
+}
+
+type configurer func(client *ObsClient)
+
+// WithSecurityToken sets the security token of temporary credentials.
+func WithSecurityToken(securityToken string) configurer {
+	return func(client *ObsClient) {
+		client.securityToken = securityToken
+	}
+}
+
+// WithHttpClient sets the HTTP client used to send the requests.
+func WithHttpClient(httpClient *http.Client) configurer {
+	return func(client *ObsClient) {
+		client.httpClient = httpClient
+	}
+}
+
+// New creates an ObsClient for the given endpoint, e.g.
+// https://obs.eu-de.otc.t-systems.com.
+func New(ak, sk, endpoint string, configurers ...configurer) (*ObsClient, error) {
+	if ak == "" || sk == "" {
+		return nil, fmt.Errorf("obs: access key and secret key are required")
+	}
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	u, err := url.Parse(strings.TrimRight(endpoint, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("obs: invalid endpoint %q: %s", endpoint, err)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("obs: invalid endpoint %q", endpoint)
+	}
+
+	client := &ObsClient{
+		ak:         ak,
+		sk:         sk,
+		endpoint:   u,
+		httpClient: http.DefaultClient,
+	}
+	for _, c := range configurers {
+		c(client)
+	}
+	return client, nil
+}
+
+// BucketDomainName returns the domain name which serves the given bucket.
+func (obsClient ObsClient) BucketDomainName(bucket string) string {
+	return bucket + "." + obsClient.endpoint.Host
+}
+
+// CreateBucket creates a bucket.
+func (obsClient ObsClient) CreateBucket(input *CreateBucketInput) (*BaseModel, error) {
+	header := http.Header{}
+	if input.ACL != "" {
+		header.Set(HeaderAcl, string(input.ACL))
+	}
+	if input.StorageClass != "" {
+		header.Set(HeaderStorageClass, string(input.StorageClass))
+	}
+
+	var body []byte
+	if input.Location != "" {
+		b, err := xml.Marshal(createBucketConfiguration{Location: input.Location})
+		if err != nil {
+			return nil, err
+		}
+		body = b
+	}
+
+	output := &BaseModel{}
+	err := obsClient.doXMLAction("PUT", input.Bucket, "", nil, header, body, output)
+	return output, err
+}
+
+// HeadBucket checks whether a bucket exists and is accessible.
+func (obsClient ObsClient) HeadBucket(bucket string) (*BaseModel, error) {
+	output := &BaseModel{}
+	err := obsClient.doXMLAction("HEAD", bucket, "", nil, nil, nil, output)
+	return output, err
+}
+
+// GetBucketMetadata returns the default storage class and the location of a bucket.
+func (obsClient ObsClient) GetBucketMetadata(bucket string) (*GetBucketMetadataOutput, error) {
+	output := &GetBucketMetadataOutput{}
+	err := obsClient.doXMLAction("HEAD", bucket, "", nil, nil, nil, &output.BaseModel)
+	if err != nil {
+		return output, err
+	}
+	output.StorageClass = StorageClassType(getHeader(output.ResponseHeaders, HeaderStorageClass))
+	output.Location = getHeader(output.ResponseHeaders, HeaderLocation)
+	return output, nil
+}
+
+// DeleteBucket deletes an empty bucket.
+func (obsClient ObsClient) DeleteBucket(bucket string) (*BaseModel, error) {
+	output := &BaseModel{}
+	err := obsClient.doXMLAction("DELETE", bucket, "", nil, nil, nil, output)
+	return output, err
+}
+
+// SetBucketAcl applies a canned ACL to a bucket.
+func (obsClient ObsClient) SetBucketAcl(input *SetBucketAclInput) (*BaseModel, error) {
+	header := http.Header{}
+	header.Set(HeaderAcl, string(input.ACL))
+
+	output := &BaseModel{}
+	err := obsClient.doXMLAction("PUT", input.Bucket, "", map[string]string{"acl": ""}, header, nil, output)
+	return output, err
+}
+
+// GetBucketAcl returns the access control list of a bucket.
+func (obsClient ObsClient) GetBucketAcl(bucket string) (*GetBucketAclOutput, error) {
+	output := &GetBucketAclOutput{}
+	err := obsClient.doXMLAction("GET", bucket, "", map[string]string{"acl": ""}, nil, nil, output)
+	return output, err
+}
+
+// SetBucketStoragePolicy sets the default storage class of a bucket.
+func (obsClient ObsClient) SetBucketStoragePolicy(input *SetBucketStoragePolicyInput) (*BaseModel, error) {
+	body, err := xml.Marshal(storageClassConfiguration{StorageClass: input.StorageClass})
+	if err != nil {
+		return nil, err
+	}
+
+	output := &BaseModel{}
+	err = obsClient.doXMLAction("PUT", input.Bucket, "", map[string]string{"storageClass": ""}, nil, body, output)
+	return output, err
+}
+
+// GetBucketStoragePolicy returns the default storage class of a bucket.
+func (obsClient ObsClient) GetBucketStoragePolicy(bucket string) (*GetBucketStoragePolicyOutput, error) {
+	output := &GetBucketStoragePolicyOutput{}
+	err := obsClient.doXMLAction("GET", bucket, "", map[string]string{"storageClass": ""}, nil, nil, output)
+	return output, err
+}
+
+// SetBucketQuota sets the storage quota of a bucket in bytes.
+func (obsClient ObsClient) SetBucketQuota(input *SetBucketQuotaInput) (*BaseModel, error) {
+	body, err := xml.Marshal(input.BucketQuota)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &BaseModel{}
+	err = obsClient.doXMLAction("PUT", input.Bucket, "", map[string]string{"quota": ""}, nil, body, output)
+	return output, err
+}
+
+// GetBucketQuota returns the storage quota of a bucket in bytes.
+func (obsClient ObsClient) GetBucketQuota(bucket string) (*GetBucketQuotaOutput, error) {
+	output := &GetBucketQuotaOutput{}
+	err := obsClient.doXMLAction("GET", bucket, "", map[string]string{"quota": ""}, nil, nil, output)
+	return output, err
+}
+
+// SetBucketVersioning enables or suspends versioning of a bucket.
+func (obsClient ObsClient) SetBucketVersioning(input *SetBucketVersioningInput) (*BaseModel, error) {
+	body, err := xml.Marshal(input.BucketVersioningConfiguration)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &BaseModel{}
+	err = obsClient.doXMLAction("PUT", input.Bucket, "", map[string]string{"versioning": ""}, nil, body, output)
+	return output, err
+}
+
+// GetBucketVersioning returns the versioning state of a bucket. The status is
+// empty if versioning has never been enabled.
+func (obsClient ObsClient) GetBucketVersioning(bucket string) (*GetBucketVersioningOutput, error) {
+	output := &GetBucketVersioningOutput{}
+	err := obsClient.doXMLAction("GET", bucket, "", map[string]string{"versioning": ""}, nil, nil, output)
+	return output, err
+}
+
+// SetBucketTagging replaces the tags of a bucket.
+func (obsClient ObsClient) SetBucketTagging(input *SetBucketTaggingInput) (*BaseModel, error) {
+	body, err := xml.Marshal(input.BucketTagging)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &BaseModel{}
+	err = obsClient.doXMLAction("PUT", input.Bucket, "", map[string]string{"tagging": ""}, nil, body, output)
+	return output, err
+}
+
+// GetBucketTagging returns the tags of a bucket. A bucket without tags
+// returns a 404 error.
+func (obsClient ObsClient) GetBucketTagging(bucket string) (*GetBucketTaggingOutput, error) {
+	output := &GetBucketTaggingOutput{}
+	err := obsClient.doXMLAction("GET", bucket, "", map[string]string{"tagging": ""}, nil, nil, output)
+	return output, err
+}
+
+// DeleteBucketTagging removes all tags of a bucket.
+func (obsClient ObsClient) DeleteBucketTagging(bucket string) (*BaseModel, error) {
+	output := &BaseModel{}
+	err := obsClient.doXMLAction("DELETE", bucket, "", map[string]string{"tagging": ""}, nil, nil, output)
+	return output, err
+}
+
+// SetBucketPolicy sets the OBS policy document of a bucket.
+func (obsClient ObsClient) SetBucketPolicy(input *SetBucketPolicyInput) (*BaseModel, error) {
+	header := http.Header{}
+	header.Set(HeaderContentType, "application/json")
+
+	output := &BaseModel{}
+	err := obsClient.doXMLAction("PUT", input.Bucket, "", map[string]string{"policy": ""}, header, []byte(input.Policy), output)
+	return output, err
+}
+
+// GetBucketPolicy returns the policy document of a bucket. A bucket without
+// a policy returns a 404 error.
+func (obsClient ObsClient) GetBucketPolicy(bucket string) (*GetBucketPolicyOutput, error) {
+	output := &GetBucketPolicyOutput{}
+	resp, err := obsClient.doAction("GET", bucket, "", map[string]string{"policy": ""}, nil, nil, 0)
+	if err != nil {
+		return output, err
+	}
+	defer resp.Body.Close()
+
+	fillBaseModel(&output.BaseModel, resp)
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return output, err
+	}
+	output.Policy = string(body)
+	return output, nil
+}
+
+// DeleteBucketPolicy removes the policy of a bucket.
+func (obsClient ObsClient) DeleteBucketPolicy(bucket string) (*BaseModel, error) {
+	output := &BaseModel{}
+	err := obsClient.doXMLAction("DELETE", bucket, "", map[string]string{"policy": ""}, nil, nil, output)
+	return output, err
+}
+
+// ListObjects returns a page of the objects of a bucket.
+func (obsClient ObsClient) ListObjects(input *ListObjectsInput) (*ListObjectsOutput, error) {
+	params := map[string]string{}
+	setParam(params, "prefix", input.Prefix)
+	setParam(params, "delimiter", input.Delimiter)
+	setParam(params, "marker", input.Marker)
+	params["max-keys"] = strconv.Itoa(maxKeys(input.MaxKeys))
+
+	output := &ListObjectsOutput{}
+	err := obsClient.doXMLAction("GET", input.Bucket, "", params, nil, nil, output)
+	return output, err
+}
+
+// ListVersions returns a page of the object versions and delete markers of a bucket.
+func (obsClient ObsClient) ListVersions(input *ListVersionsInput) (*ListVersionsOutput, error) {
+	params := map[string]string{"versions": ""}
+	setParam(params, "prefix", input.Prefix)
+	setParam(params, "key-marker", input.KeyMarker)
+	setParam(params, "version-id-marker", input.VersionIdMarker)
+	params["max-keys"] = strconv.Itoa(maxKeys(input.MaxKeys))
+
+	output := &ListVersionsOutput{}
+	err := obsClient.doXMLAction("GET", input.Bucket, "", params, nil, nil, output)
+	return output, err
+}
+
+// PutObject uploads an object in a single request.
+func (obsClient ObsClient) PutObject(input *PutObjectInput) (*PutObjectOutput, error) {
+	header := http.Header{}
+	if input.ACL != "" {
+		header.Set(HeaderAcl, string(input.ACL))
+	}
+	if input.StorageClass != "" {
+		header.Set(HeaderStorageClass, string(input.StorageClass))
+	}
+	if input.ContentType != "" {
+		header.Set(HeaderContentType, input.ContentType)
+	}
+	if input.SseKms {
+		header.Set(HeaderSseKms, sseKmsAlgorithm)
+		if input.KmsKeyId != "" {
+			header.Set(HeaderSseKmsKey, input.KmsKeyId)
+		}
+	}
+	for k, v := range input.Metadata {
+		header.Set(HeaderPrefixMetadata+k, v)
+	}
+
+	body := input.Body
+	contentLength := input.ContentLength
+	if body == nil {
+		body = bytes.NewReader(nil)
+		contentLength = 0
+	}
+
+	output := &PutObjectOutput{}
+	resp, err := obsClient.doAction("PUT", input.Bucket, input.Key, nil, header, body, contentLength)
+	if err != nil {
+		return output, err
+	}
+	defer resp.Body.Close()
+
+	fillBaseModel(&output.BaseModel, resp)
+	output.ETag = resp.Header.Get(HeaderETag)
+	output.VersionId = resp.Header.Get(HeaderVersionId)
+	output.StorageClass = StorageClassType(resp.Header.Get(HeaderStorageClass))
+	return output, nil
+}
+
+// GetObjectMetadata returns the metadata of an object without its content.
+func (obsClient ObsClient) GetObjectMetadata(input *GetObjectMetadataInput) (*GetObjectMetadataOutput, error) {
+	params := map[string]string{}
+	setParam(params, "versionId", input.VersionId)
+
+	output := &GetObjectMetadataOutput{}
+	resp, err := obsClient.doAction("HEAD", input.Bucket, input.Key, params, nil, nil, 0)
+	if err != nil {
+		return output, err
+	}
+	resp.Body.Close()
+
+	fillObjectMetadata(output, resp)
+	return output, nil
+}
+
+// GetObject returns the metadata and the content of an object.
+func (obsClient ObsClient) GetObject(input *GetObjectInput) (*GetObjectOutput, error) {
+	params := map[string]string{}
+	setParam(params, "versionId", input.VersionId)
+
+	output := &GetObjectOutput{}
+	resp, err := obsClient.doAction("GET", input.Bucket, input.Key, params, nil, nil, 0)
+	if err != nil {
+		return output, err
+	}
+
+	fillObjectMetadata(&output.GetObjectMetadataOutput, resp)
+	output.Body = resp.Body
+	return output, nil
+}
+
+// DeleteObject deletes an object or, if VersionId is set, one of its versions.
+func (obsClient ObsClient) DeleteObject(input *DeleteObjectInput) (*DeleteObjectOutput, error) {
+	params := map[string]string{}
+	setParam(params, "versionId", input.VersionId)
+
+	output := &DeleteObjectOutput{}
+	err := obsClient.doXMLAction("DELETE", input.Bucket, input.Key, params, nil, nil, &output.BaseModel)
+	if err != nil {
+		return output, err
+	}
+	output.VersionId = getHeader(output.ResponseHeaders, HeaderVersionId)
+	output.DeleteMarker = getHeader(output.ResponseHeaders, HeaderDeleteMarker) == "true"
+	return output, nil
+}
+
+// doXMLAction sends a request with an optional XML body and decodes an XML
+// response body into output, which must embed BaseModel or be a *BaseModel.
+func (obsClient ObsClient) doXMLAction(method, bucket, key string, params map[string]string,
+	header http.Header, body []byte, output interface{}) error {
+	if header == nil {
+		header = http.Header{}
+	}
+
+	var reader io.Reader
+	if body != nil {
+		sum := md5.Sum(body)
+		header.Set(HeaderContentMD5, base64.StdEncoding.EncodeToString(sum[:]))
+		if header.Get(HeaderContentType) == "" {
+			header.Set(HeaderContentType, "application/xml")
+		}
+		reader = bytes.NewReader(body)
+	}
+
+	resp, err := obsClient.doAction(method, bucket, key, params, header, reader, int64(len(body)))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if len(respBody) > 0 {
+		if _, ok := output.(*BaseModel); !ok {
+			if err := xml.Unmarshal(respBody, output); err != nil {
+				return fmt.Errorf("obs: failed to parse response of %s %s: %s", method, bucket, err)
+			}
+		}
+	}
+
+	if base, ok := output.(interface{ baseModel() *BaseModel }); ok {
+		fillBaseModel(base.baseModel(), resp)
+	}
+	return nil
+}
+
+func (model *BaseModel) baseModel() *BaseModel {
+	return model
+}
+
+// doAction signs and sends a request. Responses with a status code of 300 or
+// above are returned as ObsError.
+func (obsClient ObsClient) doAction(method, bucket, key string, params map[string]string,
+	header http.Header, body io.Reader, contentLength int64) (*http.Response, error) {
+	if header == nil {
+		header = http.Header{}
+	}
+
+	u := *obsClient.endpoint
+	if bucket != "" {
+		u.Host = obsClient.BucketDomainName(bucket)
+	}
+	u.Path = "/" + key
+	u.RawPath = "/" + escapeKey(key)
+	u.RawQuery = encodeParams(params)
+
+	req, err := http.NewRequest(method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	if contentLength > 0 {
+		req.ContentLength = contentLength
+	}
+
+	for k, v := range header {
+		req.Header[http.CanonicalHeaderKey(k)] = v
+	}
+	if obsClient.securityToken != "" {
+		req.Header.Set(HeaderSecurityToken, obsClient.securityToken)
+	}
+	req.Header.Set(HeaderDate, time.Now().UTC().Format(http.TimeFormat))
+	req.Header.Set(HeaderAuthorization, fmt.Sprintf("OBS %s:%s", obsClient.ak,
+		signature(obsClient.sk, stringToSign(method, bucket, escapeKey(key), params, req.Header))))
+
+	resp, err := obsClient.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		obsError := ObsError{}
+		if respBody, err := ioutil.ReadAll(resp.Body); err == nil && len(respBody) > 0 {
+			xml.Unmarshal(respBody, &obsError)
+		}
+		fillBaseModel(&obsError.BaseModel, resp)
+		obsError.Status = resp.Status
+		if obsError.Code == "" {
+			obsError.Code = http.StatusText(resp.StatusCode)
+		}
+		return nil, obsError
+	}
+
+	return resp, nil
+}
+
+func fillBaseModel(model *BaseModel, resp *http.Response) {
+	model.StatusCode = resp.StatusCode
+	model.ResponseHeaders = lowerHeaders(resp.Header)
+	if requestId := resp.Header.Get(HeaderRequestId); requestId != "" {
+		model.RequestId = requestId
+	}
+}
+
+func fillObjectMetadata(output *GetObjectMetadataOutput, resp *http.Response) {
+	fillBaseModel(&output.BaseModel, resp)
+	output.ContentType = resp.Header.Get(HeaderContentType)
+	output.ContentLength = resp.ContentLength
+	output.ETag = resp.Header.Get(HeaderETag)
+	output.VersionId = resp.Header.Get(HeaderVersionId)
+	output.StorageClass = StorageClassType(resp.Header.Get(HeaderStorageClass))
+	if output.StorageClass == "" {
+		output.StorageClass = StorageClassStandard
+	}
+	output.SseKms = resp.Header.Get(HeaderSseKms) == sseKmsAlgorithm
+	output.KmsKeyId = resp.Header.Get(HeaderSseKmsKey)
+	if t, err := time.Parse(http.TimeFormat, resp.Header.Get(HeaderLastModified)); err == nil {
+		output.LastModified = t
+	}
+
+	output.Metadata = make(map[string]string)
+	for k, v := range output.ResponseHeaders {
+		if strings.HasPrefix(k, HeaderPrefixMetadata) && len(v) > 0 {
+			output.Metadata[strings.TrimPrefix(k, HeaderPrefixMetadata)] = v[0]
+		}
+	}
+}
+
+func lowerHeaders(header http.Header) map[string][]string {
+	headers := make(map[string][]string, len(header))
+	for k, v := range header {
+		headers[strings.ToLower(k)] = v
+	}
+	return headers
+}
+
+func getHeader(headers map[string][]string, key string) string {
+	if v, ok := headers[strings.ToLower(key)]; ok && len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func setParam(params map[string]string, key, value string) {
+	if value != "" {
+		params[key] = value
+	}
+}
+
+func maxKeys(n int) int {
+	if n <= 0 || n > defaultMaxKeys {
+		return defaultMaxKeys
+	}
+	return n
+}
+
+// escapeKey escapes an object key for the request path while keeping the
+// "/" separators.
+func escapeKey(key string) string {
+	parts := strings.Split(key, "/")
+	for i, p := range parts {
+		parts[i] = strings.Replace(url.QueryEscape(p), "+", "%20", -1)
+	}
+	return strings.Join(parts, "/")
+}
+
+// encodeParams encodes the query string. Sub-resources without a value are
+// sent as bare keys, e.g. "?acl".
+func encodeParams(params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if v := params[k]; v != "" {
+			parts = append(parts, url.QueryEscape(k)+"="+url.QueryEscape(v))
+		} else {
+			parts = append(parts, url.QueryEscape(k))
+		}
+	}
+	return strings.Join(parts, "&")
+}
@@ -0,0 +1,33 @@
+/*
+Package obs provides a native client for the Object Storage Service (OBS).
+
+OBS is not an OpenStack service and does not accept Keystone tokens, so the
+client signs every request with an access key/secret key pair using the OBS
+signature. Requests are sent virtual-host style, i.e. to
+https://<bucket>.<endpoint host>/<object key>.
+
+Example to create a bucket with a default storage class
+
+	client, err := obs.New(accessKey, secretKey, "https://obs.eu-de.otc.t-systems.com")
+	if err != nil {
+		panic(err)
+	}
+
+	input := &obs.CreateBucketInput{
+		Bucket:       "my-bucket",
+		StorageClass: obs.StorageClassWarm,
+		ACL:          obs.AclPrivate,
+	}
+	_, err = client.CreateBucket(input)
+	if err != nil {
+		panic(err)
+	}
+
+Example to check whether an error is a "not found" response
+
+	_, err := client.HeadBucket("my-bucket")
+	if obsError, ok := err.(obs.ObsError); ok && obsError.StatusCode == 404 {
+		// the bucket does not exist
+	}
+*/
+package obs
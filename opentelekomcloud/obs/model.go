@@ -0,0 +1,404 @@
+package obs
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	HeaderPrefixObs      = "x-obs-"
+	HeaderDate           = "Date"
+	HeaderDateObs        = "x-obs-date"
+	HeaderContentMD5     = "Content-MD5"
+	HeaderContentType    = "Content-Type"
+	HeaderContentLength  = "Content-Length"
+	HeaderETag           = "ETag"
+	HeaderLastModified   = "Last-Modified"
+	HeaderAuthorization  = "Authorization"
+	HeaderAcl            = "x-obs-acl"
+	HeaderStorageClass   = "x-obs-storage-class"
+	HeaderLocation       = "x-obs-bucket-location"
+	HeaderVersionId      = "x-obs-version-id"
+	HeaderDeleteMarker   = "x-obs-delete-marker"
+	HeaderSecurityToken  = "x-obs-security-token"
+	HeaderRequestId      = "x-obs-request-id"
+	HeaderSseKms         = "x-obs-server-side-encryption"
+	HeaderSseKmsKey      = "x-obs-server-side-encryption-kms-key-id"
+	HeaderPrefixMetadata = "x-obs-meta-"
+	defaultMaxKeys       = 1000
+	sseKmsAlgorithm      = "kms"
+	versioningEnabled    = "Enabled"
+	versioningSuspended  = "Suspended"
+)
+
+// StorageClassType is the storage class of a bucket or an object.
+type StorageClassType string
+
+const (
+	StorageClassStandard StorageClassType = "STANDARD"
+	StorageClassWarm     StorageClassType = "WARM"
+	StorageClassCold     StorageClassType = "COLD"
+)
+
+// AclType is a canned access control list.
+type AclType string
+
+const (
+	AclPrivate                  AclType = "private"
+	AclPublicRead               AclType = "public-read"
+	AclPublicReadWrite          AclType = "public-read-write"
+	AclPublicReadDelivered      AclType = "public-read-delivered"
+	AclPublicReadWriteDelivered AclType = "public-read-write-delivered"
+	AclBucketOwnerFullControl   AclType = "bucket-owner-full-control"
+	AclLogDeliveryWrite         AclType = "log-delivery-write"
+)
+
+// PermissionType is the permission of a grant of an access control list.
+type PermissionType string
+
+const (
+	PermissionRead        PermissionType = "READ"
+	PermissionWrite       PermissionType = "WRITE"
+	PermissionReadAcp     PermissionType = "READ_ACP"
+	PermissionWriteAcp    PermissionType = "WRITE_ACP"
+	PermissionFullControl PermissionType = "FULL_CONTROL"
+)
+
+// GroupEveryone is the canned grantee of all users.
+const GroupEveryone = "Everyone"
+
+// VersioningStatusType is the versioning state of a bucket.
+type VersioningStatusType string
+
+const (
+	VersioningStatusEnabled   VersioningStatusType = versioningEnabled
+	VersioningStatusSuspended VersioningStatusType = versioningSuspended
+)
+
+// BaseModel contains the common part of every OBS response.
+type BaseModel struct {
+	StatusCode      int                 `xml:"-"`
+	RequestId       string              `xml:"RequestId"`
+	ResponseHeaders map[string][]string `xml:"-"`
+}
+
+// ObsError is returned for every response with a status code of 300 or above.
+type ObsError struct {
+	BaseModel
+	Status   string
+	XMLName  xml.Name `xml:"Error"`
+	Code     string   `xml:"Code"`
+	Message  string   `xml:"Message"`
+	Resource string   `xml:"Resource"`
+	HostId   string   `xml:"HostId"`
+}
+
+func (err ObsError) Error() string {
+	return fmt.Sprintf("obs: service returned error: Status=%s, Code=%s, Message=%s, RequestId=%s",
+		err.Status, err.Code, err.Message, err.RequestId)
+}
+
+// CreateBucketInput is the input of CreateBucket.
+type CreateBucketInput struct {
+	Bucket       string
+	ACL          AclType
+	StorageClass StorageClassType
+	Location     string
+}
+
+type createBucketConfiguration struct {
+	XMLName  xml.Name `xml:"CreateBucketConfiguration"`
+	Location string   `xml:"Location"`
+}
+
+// GetBucketMetadataOutput is the output of GetBucketMetadata.
+type GetBucketMetadataOutput struct {
+	BaseModel
+	StorageClass StorageClassType
+	Location     string
+}
+
+// SetBucketAclInput is the input of SetBucketAcl.
+type SetBucketAclInput struct {
+	Bucket string
+	ACL    AclType
+}
+
+// Owner is the owner of a bucket.
+type Owner struct {
+	ID string `xml:"ID"`
+}
+
+// Grantee is the user or the group a grant applies to. A user has an ID, a
+// group the canned name of OBS, e.g. "Everyone", or the URI of the group of
+// the S3 compatible API.
+type Grantee struct {
+	ID     string `xml:"ID"`
+	Canned string `xml:"Canned"`
+	URI    string `xml:"URI"`
+}
+
+// Grant is a permission given to a grantee. Delivered tells whether the
+// grant of a bucket is inherited by its objects.
+type Grant struct {
+	Grantee    Grantee        `xml:"Grantee"`
+	Permission PermissionType `xml:"Permission"`
+	Delivered  bool           `xml:"Delivered"`
+}
+
+// AccessControlPolicy is the access control list of a bucket.
+type AccessControlPolicy struct {
+	XMLName xml.Name `xml:"AccessControlPolicy"`
+	Owner   Owner    `xml:"Owner"`
+	Grants  []Grant  `xml:"AccessControlList>Grant"`
+}
+
+// GetBucketAclOutput is the output of GetBucketAcl.
+type GetBucketAclOutput struct {
+	BaseModel
+	AccessControlPolicy
+}
+
+// SetBucketStoragePolicyInput is the input of SetBucketStoragePolicy.
+type SetBucketStoragePolicyInput struct {
+	Bucket       string
+	StorageClass StorageClassType
+}
+
+type storageClassConfiguration struct {
+	XMLName      xml.Name         `xml:"StorageClass"`
+	StorageClass StorageClassType `xml:",chardata"`
+}
+
+// GetBucketStoragePolicyOutput is the output of GetBucketStoragePolicy.
+type GetBucketStoragePolicyOutput struct {
+	BaseModel
+	XMLName      xml.Name         `xml:"StorageClass"`
+	StorageClass StorageClassType `xml:",chardata"`
+}
+
+// SetBucketQuotaInput is the input of SetBucketQuota. A quota of 0 means
+// that the bucket is not limited.
+type SetBucketQuotaInput struct {
+	Bucket string
+	BucketQuota
+}
+
+// BucketQuota is the storage quota of a bucket in bytes.
+type BucketQuota struct {
+	XMLName xml.Name `xml:"Quota"`
+	Quota   int64    `xml:"StorageQuota"`
+}
+
+// GetBucketQuotaOutput is the output of GetBucketQuota.
+type GetBucketQuotaOutput struct {
+	BaseModel
+	BucketQuota
+}
+
+// SetBucketVersioningInput is the input of SetBucketVersioning.
+type SetBucketVersioningInput struct {
+	Bucket string
+	BucketVersioningConfiguration
+}
+
+// BucketVersioningConfiguration is the versioning configuration of a bucket.
+type BucketVersioningConfiguration struct {
+	XMLName xml.Name             `xml:"VersioningConfiguration"`
+	Status  VersioningStatusType `xml:"Status,omitempty"`
+}
+
+// GetBucketVersioningOutput is the output of GetBucketVersioning.
+type GetBucketVersioningOutput struct {
+	BaseModel
+	BucketVersioningConfiguration
+}
+
+// Tag is a key/value pair attached to a bucket.
+type Tag struct {
+	XMLName xml.Name `xml:"Tag"`
+	Key     string   `xml:"Key"`
+	Value   string   `xml:"Value"`
+}
+
+// BucketTagging is the tag set of a bucket.
+type BucketTagging struct {
+	XMLName xml.Name `xml:"Tagging"`
+	Tags    []Tag    `xml:"TagSet>Tag"`
+}
+
+// SetBucketTaggingInput is the input of SetBucketTagging.
+type SetBucketTaggingInput struct {
+	Bucket string
+	BucketTagging
+}
+
+// GetBucketTaggingOutput is the output of GetBucketTagging.
+type GetBucketTaggingOutput struct {
+	BaseModel
+	BucketTagging
+}
+
+// SetBucketPolicyInput is the input of SetBucketPolicy.
+type SetBucketPolicyInput struct {
+	Bucket string
+	Policy string
+}
+
+// GetBucketPolicyOutput is the output of GetBucketPolicy.
+type GetBucketPolicyOutput struct {
+	BaseModel
+	Policy string
+}
+
+// ListObjectsInput is the input of ListObjects.
+type ListObjectsInput struct {
+	Bucket    string
+	Prefix    string
+	Delimiter string
+	Marker    string
+	MaxKeys   int
+}
+
+// Content is an object returned by ListObjects.
+type Content struct {
+	XMLName      xml.Name         `xml:"Contents"`
+	Key          string           `xml:"Key"`
+	LastModified time.Time        `xml:"LastModified"`
+	ETag         string           `xml:"ETag"`
+	Size         int64            `xml:"Size"`
+	StorageClass StorageClassType `xml:"StorageClass"`
+}
+
+// ListObjectsOutput is the output of ListObjects.
+type ListObjectsOutput struct {
+	BaseModel
+	XMLName        xml.Name  `xml:"ListBucketResult"`
+	Delimiter      string    `xml:"Delimiter"`
+	IsTruncated    bool      `xml:"IsTruncated"`
+	Marker         string    `xml:"Marker"`
+	NextMarker     string    `xml:"NextMarker"`
+	MaxKeys        int       `xml:"MaxKeys"`
+	Name           string    `xml:"Name"`
+	Prefix         string    `xml:"Prefix"`
+	Contents       []Content `xml:"Contents"`
+	CommonPrefixes []string  `xml:"CommonPrefixes>Prefix"`
+}
+
+// ListVersionsInput is the input of ListVersions.
+type ListVersionsInput struct {
+	Bucket          string
+	Prefix          string
+	KeyMarker       string
+	VersionIdMarker string
+	MaxKeys         int
+}
+
+// Version is an object version returned by ListVersions.
+type Version struct {
+	XMLName      xml.Name         `xml:"Version"`
+	Key          string           `xml:"Key"`
+	VersionId    string           `xml:"VersionId"`
+	IsLatest     bool             `xml:"IsLatest"`
+	LastModified time.Time        `xml:"LastModified"`
+	ETag         string           `xml:"ETag"`
+	Size         int64            `xml:"Size"`
+	StorageClass StorageClassType `xml:"StorageClass"`
+}
+
+// DeleteMarker is a delete marker returned by ListVersions.
+type DeleteMarker struct {
+	XMLName      xml.Name  `xml:"DeleteMarker"`
+	Key          string    `xml:"Key"`
+	VersionId    string    `xml:"VersionId"`
+	IsLatest     bool      `xml:"IsLatest"`
+	LastModified time.Time `xml:"LastModified"`
+}
+
+// ListVersionsOutput is the output of ListVersions.
+type ListVersionsOutput struct {
+	BaseModel
+	XMLName             xml.Name       `xml:"ListVersionsResult"`
+	IsTruncated         bool           `xml:"IsTruncated"`
+	KeyMarker           string         `xml:"KeyMarker"`
+	NextKeyMarker       string         `xml:"NextKeyMarker"`
+	VersionIdMarker     string         `xml:"VersionIdMarker"`
+	NextVersionIdMarker string         `xml:"NextVersionIdMarker"`
+	Name                string         `xml:"Name"`
+	Prefix              string         `xml:"Prefix"`
+	Versions            []Version      `xml:"Version"`
+	DeleteMarkers       []DeleteMarker `xml:"DeleteMarker"`
+}
+
+// PutObjectInput is the input of PutObject. Body may be nil to create an
+// empty object.
+type PutObjectInput struct {
+	Bucket        string
+	Key           string
+	ACL           AclType
+	StorageClass  StorageClassType
+	ContentType   string
+	ContentLength int64
+	// SseKms enables server side encryption with the given KMS key ID. An
+	// empty KmsKeyId selects the default key of the project.
+	SseKms   bool
+	KmsKeyId string
+	Metadata map[string]string
+	Body     io.Reader
+}
+
+// PutObjectOutput is the output of PutObject.
+type PutObjectOutput struct {
+	BaseModel
+	ETag         string
+	VersionId    string
+	StorageClass StorageClassType
+}
+
+// GetObjectMetadataInput is the input of GetObjectMetadata.
+type GetObjectMetadataInput struct {
+	Bucket    string
+	Key       string
+	VersionId string
+}
+
+// GetObjectMetadataOutput is the output of GetObjectMetadata.
+type GetObjectMetadataOutput struct {
+	BaseModel
+	ContentType   string
+	ContentLength int64
+	ETag          string
+	LastModified  time.Time
+	VersionId     string
+	StorageClass  StorageClassType
+	SseKms        bool
+	KmsKeyId      string
+	Metadata      map[string]string
+}
+
+// GetObjectInput is the input of GetObject.
+type GetObjectInput struct {
+	GetObjectMetadataInput
+}
+
+// GetObjectOutput is the output of GetObject. The caller must close Body.
+type GetObjectOutput struct {
+	GetObjectMetadataOutput
+	Body io.ReadCloser
+}
+
+// DeleteObjectInput is the input of DeleteObject.
+type DeleteObjectInput struct {
+	Bucket    string
+	Key       string
+	VersionId string
+}
+
+// DeleteObjectOutput is the output of DeleteObject.
+type DeleteObjectOutput struct {
+	BaseModel
+	VersionId    string
+	DeleteMarker bool
+}
@@ -153,15 +153,38 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("OS_SWAUTH", ""),
 				Description: descriptions["swauth"],
 			},
+
+			"default_tags": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: descriptions["default_tags"],
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": &schema.Schema{
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
+			"opentelekomcloud_ces_metrics":                dataSourceCESMetrics(),
+			"opentelekomcloud_ces_metric_data":            dataSourceCESMetricData(),
+			"opentelekomcloud_ces_events":                 dataSourceCESEvents(),
+			"opentelekomcloud_dns_zone_v2":                dataSourceDNSZoneV2(),
 			"opentelekomcloud_images_image_v2":            dataSourceImagesImageV2(),
 			"opentelekomcloud_networking_network_v2":      dataSourceNetworkingNetworkV2(),
 			"opentelekomcloud_networking_secgroup_v2":     dataSourceNetworkingSecGroupV2(),
 			"opentelekomcloud_s3_bucket_object":           dataSourceS3BucketObject(),
+			"opentelekomcloud_s3_bucket_objects":          dataSourceS3BucketObjects(),
+			"opentelekomcloud_s3_presigned_url":           dataSourceS3PresignedURL(),
+			"opentelekomcloud_cts_traces":                 dataSourceCTSTraces(),
 			"opentelekomcloud_kms_key_v1":                 dataSourceKmsKeyV1(),
 			"opentelekomcloud_kms_data_key_v1":            dataSourceKmsDataKeyV1(),
+			"opentelekomcloud_kms_secrets_v1":             dataSourceKmsSecretsV1(),
 			"opentelekomcloud_rds_flavors_v1":             dataSourceRdsFlavorV1(),
 			"opentelekomcloud_vpc_v1":                     dataSourceVirtualPrivateCloudVpcV1(),
 			"opentelekomcloud_vpc_peering_connection_v2":  dataSourceVpcPeeringConnectionV2(),
@@ -173,7 +196,14 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_rts_software_config_v1":     dataSourceRtsSoftwareConfigV1(),
 			"opentelekomcloud_rts_stack_v1":               dataSourceRTSStackV1(),
 			"opentelekomcloud_rts_stack_resource_v1":      dataSourceRTSStackResourcesV1(),
+			"opentelekomcloud_rts_stack_preview_v1":       dataSourceRTSStackPreviewV1(),
+			"opentelekomcloud_rts_stack_events_v1":        dataSourceRTSStackEventsV1(),
+			"opentelekomcloud_rts_resource_types_v1":      dataSourceRTSResourceTypesV1(),
+			"opentelekomcloud_rts_template_validation_v1": dataSourceRTSTemplateValidationV1(),
 			"opentelekomcloud_sfs_file_system_v2":         dataSourceSFSFileSystemV2(),
+			"opentelekomcloud_sfs_turbo_v1":               dataSourceSFSTurboV1(),
+			"opentelekomcloud_smn_topic_v2":               dataSourceSmnTopicV2(),
+			"opentelekomcloud_lb_certificate_v2":          dataSourceCertificateV2(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -186,17 +216,25 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_compute_floatingip_associate_v2":    resourceComputeFloatingIPAssociateV2(),
 			"opentelekomcloud_compute_volume_attach_v2":           resourceComputeVolumeAttachV2(),
 			"opentelekomcloud_dns_recordset_v2":                   resourceDNSRecordSetV2(),
+			"opentelekomcloud_dns_ptrrecord_v2":                   resourceDNSPtrRecordV2(),
 			"opentelekomcloud_dns_zone_v2":                        resourceDNSZoneV2(),
 			"opentelekomcloud_fw_firewall_group_v2":               resourceFWFirewallGroupV2(),
 			"opentelekomcloud_fw_policy_v2":                       resourceFWPolicyV2(),
 			"opentelekomcloud_fw_rule_v2":                         resourceFWRuleV2(),
 			"opentelekomcloud_images_image_v2":                    resourceImagesImageV2(),
 			"opentelekomcloud_kms_key_v1":                         resourceKmsKeyV1(),
+			"opentelekomcloud_kms_grant_v1":                       resourceKmsGrantV1(),
+			"opentelekomcloud_kms_ciphertext_v1":                  resourceKmsCiphertextV1(),
 			"opentelekomcloud_lb_loadbalancer_v2":                 resourceLoadBalancerV2(),
 			"opentelekomcloud_lb_listener_v2":                     resourceListenerV2(),
 			"opentelekomcloud_lb_pool_v2":                         resourcePoolV2(),
 			"opentelekomcloud_lb_member_v2":                       resourceMemberV2(),
+			"opentelekomcloud_lb_members_v2":                      resourceMembersV2(),
 			"opentelekomcloud_lb_monitor_v2":                      resourceMonitorV2(),
+			"opentelekomcloud_lb_l7policy_v2":                     resourceL7PolicyV2(),
+			"opentelekomcloud_lb_l7rule_v2":                       resourceL7RuleV2(),
+			"opentelekomcloud_lb_certificate_v2":                  resourceCertificateV2(),
+			"opentelekomcloud_lb_whitelist_v2":                    resourceWhitelistV2(),
 			"opentelekomcloud_networking_network_v2":              resourceNetworkingNetworkV2(),
 			"opentelekomcloud_networking_subnet_v2":               resourceNetworkingSubnetV2(),
 			"opentelekomcloud_networking_floatingip_v2":           resourceNetworkingFloatingIPV2(),
@@ -207,15 +245,30 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_networking_secgroup_v2":             resourceNetworkingSecGroupV2(),
 			"opentelekomcloud_networking_secgroup_rule_v2":        resourceNetworkingSecGroupRuleV2(),
 			"opentelekomcloud_s3_bucket":                          resourceS3Bucket(),
+			"opentelekomcloud_s3_bucket_notification":             resourceS3BucketNotification(),
 			"opentelekomcloud_s3_bucket_policy":                   resourceS3BucketPolicy(),
 			"opentelekomcloud_s3_bucket_object":                   resourceS3BucketObject(),
+			"opentelekomcloud_s3_bucket_objects_sync":             resourceS3BucketObjectsSync(),
+			"opentelekomcloud_obs_bucket":                         resourceObsBucket(),
+			"opentelekomcloud_obs_bucket_object":                  resourceObsBucketObject(),
+			"opentelekomcloud_obs_bucket_policy":                  resourceObsBucketPolicy(),
 			"opentelekomcloud_elb_loadbalancer":                   resourceELoadBalancer(),
 			"opentelekomcloud_elb_listener":                       resourceEListener(),
 			"opentelekomcloud_elb_backend":                        resourceBackend(),
 			"opentelekomcloud_elb_health":                         resourceHealth(),
+			"opentelekomcloud_elb_certificate":                    resourceECertificate(),
+			"opentelekomcloud_elb_whitelist":                      resourceEWhitelist(),
+			"opentelekomcloud_cts_tracker_v1":                     resourceCTSTrackerV1(),
+			"opentelekomcloud_cts_key_event_notification_v1":      resourceCTSKeyEventNotificationV1(),
+			"opentelekomcloud_lts_group_v2":                       resourceLTSGroupV2(),
+			"opentelekomcloud_lts_topic_v2":                       resourceLTSTopicV2(),
+			"opentelekomcloud_lts_ingestion_v3":                   resourceLTSIngestionV3(),
 			"opentelekomcloud_ces_alarmrule":                      resourceAlarmRule(),
+			"opentelekomcloud_ces_alarm_template":                 resourceAlarmTemplate(),
 			"opentelekomcloud_smn_topic_v2":                       resourceTopic(),
 			"opentelekomcloud_smn_subscription_v2":                resourceSubscription(),
+			"opentelekomcloud_smn_message_template_v2":            resourceSmnMessageTemplateV2(),
+			"opentelekomcloud_smn_message_v2":                     resourceSmnMessageV2(),
 			"opentelekomcloud_rds_instance_v1":                    resourceRdsInstance(),
 			"opentelekomcloud_vpc_eip_v1":                         resourceVpcEIPV1(),
 			"opentelekomcloud_vpc_v1":                             resourceVirtualPrivateCloudV1(),
@@ -226,7 +279,9 @@ func Provider() terraform.ResourceProvider {
 			"opentelekomcloud_rts_software_deployment_v1":         resourceRtsSoftwareDeploymentV1(),
 			"opentelekomcloud_rts_software_config_v1":             resourceSoftwareConfigV1(),
 			"opentelekomcloud_rts_stack_v1":                       resourceRTSStackV1(),
+			"opentelekomcloud_sfs_access_rule_v2":                 resourceSFSAccessRuleV2(),
 			"opentelekomcloud_sfs_file_system_v2":                 resourceSFSFileSystemV2(),
+			"opentelekomcloud_sfs_turbo_v1":                       resourceSFSTurboV1(),
 		},
 
 		ConfigureFunc: configureProvider,
@@ -277,6 +332,8 @@ func init() {
 
 		"swauth": "Use Swift's authentication system instead of Keystone. Only used for\n" +
 			"interaction with Swift.",
+
+		"default_tags": "Tags to apply to all the resources supporting tags.",
 	}
 }
 
@@ -300,6 +357,7 @@ func configureProvider(d *schema.ResourceData) (interface{}, error) {
 		TenantName:       d.Get("tenant_name").(string),
 		Username:         d.Get("user_name").(string),
 		UserID:           d.Get("user_id").(string),
+		DefaultTags:      expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
 	}
 
 	if err := config.LoadAndValidate(); err != nil {
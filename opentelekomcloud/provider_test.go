@@ -30,6 +30,7 @@ var (
 	OS_VPC_ID                 = os.Getenv("OS_VPC_ID")
 	OS_SUBNET_ID              = os.Getenv("OS_SUBNET_ID")
 	OS_TENANT_ID              = os.Getenv("OS_TENANT_ID")
+	OS_OBS_AGENCY             = os.Getenv("OS_OBS_AGENCY")
 )
 
 var testAccProviders map[string]terraform.ResourceProvider
@@ -141,6 +142,14 @@ func testAccPreCheckSwift(t *testing.T) {
 	}
 }
 
+func testAccPreCheckS3Replication(t *testing.T) {
+	testAccPreCheck(t)
+
+	if OS_OBS_AGENCY == "" {
+		t.Skip("OS_OBS_AGENCY must be set for S3 bucket replication tests")
+	}
+}
+
 func testAccPreCheckAdminOnly(t *testing.T) {
 	v := os.Getenv("OS_USERNAME")
 	if v != "admin" {
@@ -95,10 +95,8 @@ func resourceBlockStorageVolumeV2() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
-			"tags": &schema.Schema{
-				Type:     schema.TypeMap,
-				Optional: true,
-			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 			"attachment": &schema.Schema{
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -132,14 +130,6 @@ func resourceContainerMetadataV2(d *schema.ResourceData) map[string]string {
 	return m
 }
 
-func resourceContainerTags(d *schema.ResourceData) map[string]string {
-	m := make(map[string]string)
-	for key, val := range d.Get("tags").(map[string]interface{}) {
-		m[key] = val.(string)
-	}
-	return m
-}
-
 func resourceBlockStorageVolumeV2Create(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	blockStorageClient, err := config.blockStorageV2Client(GetRegion(d, config))
@@ -188,9 +178,11 @@ func resourceBlockStorageVolumeV2Create(d *schema.ResourceData, meta interface{}
 			"Error waiting for volume (%s) to become ready: %s",
 			v.ID, err)
 	}
-	_, err = resourceEVSTagV2Create(d, meta, "volumes", v.ID, resourceContainerTags(d))
-	if err != nil {
-		return fmt.Errorf("Error creating tags for volume (%s): %s", v.ID, err)
+	if tags := resourceTags(d, meta); len(tags) > 0 {
+		_, err = resourceEVSTagV2Create(d, meta, "volumes", v.ID, tags)
+		if err != nil {
+			return fmt.Errorf("Error creating tags for volume (%s): %s", v.ID, err)
+		}
 	}
 
 	// Store the ID now
@@ -240,7 +232,9 @@ func resourceBlockStorageVolumeV2Read(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return fmt.Errorf("Error fetching tags for volume (%s): %s", v.ID, err)
 	}
-	d.Set("tags", taglist)
+	if err := setStateTags(d, meta, taglist.Tags); err != nil {
+		return fmt.Errorf("Error saving tags for volume (%s): %s", v.ID, err)
+	}
 
 	return nil
 }
@@ -265,8 +259,11 @@ func resourceBlockStorageVolumeV2Update(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		return fmt.Errorf("Error updating OpenTelekomCloud volume: %s", err)
 	}
-	if d.HasChange("tags") {
-		_, err = resourceEVSTagV2Create(d, meta, "volumes", d.Id(), resourceContainerTags(d))
+	if resourceTagsChanged(d, meta) {
+		_, err = resourceEVSTagV2Create(d, meta, "volumes", d.Id(), resourceTags(d, meta))
+		if err != nil {
+			return fmt.Errorf("Error updating tags for volume (%s): %s", d.Id(), err)
+		}
 	}
 
 	return resourceBlockStorageVolumeV2Read(d, meta)
@@ -0,0 +1,393 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/cloudeyeservice/alarmrule"
+	"github.com/huaweicloud/golangsdk/openstack/cloudeyeservice/alarmtemplate"
+)
+
+const nameCESAT = "CES-AlarmTemplate"
+
+func resourceAlarmTemplate() *schema.Resource {
+	// the conditions and actions of a template item are the same as the
+	// ones of a single alarm rule, so share their schemas.
+	ar := resourceAlarmRule().Schema
+	item := map[string]*schema.Schema{
+		"metric_name": ar["metric"].Elem.(*schema.Resource).Schema["metric_name"],
+		"alarm_level": &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  2,
+			ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+				return ValidateIntRange(v, k, 1, 4)
+			},
+		},
+	}
+	for k, v := range ar["condition"].Elem.(*schema.Resource).Schema {
+		item[k] = v
+	}
+
+	return &schema.Resource{
+		Create: resourceAlarmTemplateCreate,
+		Read:   resourceAlarmTemplateRead,
+		Update: resourceAlarmTemplateUpdate,
+		Delete: resourceAlarmTemplateDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					vv := regexp.MustCompile("^[a-zA-Z0-9_]{1,64}$")
+					if !vv.MatchString(value) {
+						errors = append(errors, fmt.Errorf("%s must be string of 1 to 64 characters that consists of uppercase/lowercae letters, digits and underscores(_)", k))
+					}
+					return
+				},
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if len(value) > 256 {
+						errors = append(errors, fmt.Errorf("The length of %s must be in [0, 256]", k))
+					}
+					return
+				},
+			},
+
+			"namespace": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: ar["metric"].Elem.(*schema.Resource).Schema["namespace"].ValidateFunc,
+			},
+
+			"dimension_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"item": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: item,
+				},
+			},
+
+			"dimension_values": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"alarm_actions":            ar["alarm_actions"],
+			"insufficientdata_actions": ar["insufficientdata_actions"],
+			"ok_actions":               ar["ok_actions"],
+
+			"alarm_enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"alarm_action_enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"alarm_ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func getAlarmTemplateItems(d *schema.ResourceData) []alarmtemplate.ItemOpts {
+	items := d.Get("item").([]interface{})
+	opts := make([]alarmtemplate.ItemOpts, len(items))
+	for i, v := range items {
+		item := v.(map[string]interface{})
+		opts[i] = alarmtemplate.ItemOpts{
+			Namespace:     d.Get("namespace").(string),
+			DimensionName: d.Get("dimension_name").(string),
+			MetricName:    item["metric_name"].(string),
+			Condition: alarmtemplate.ConditionOpts{
+				Period:             item["period"].(int),
+				Filter:             item["filter"].(string),
+				ComparisonOperator: item["comparison_operator"].(string),
+				Value:              item["value"].(int),
+				Unit:               item["unit"].(string),
+				Count:              item["count"].(int),
+			},
+			AlarmLevel: item["alarm_level"].(int),
+		}
+	}
+	return opts
+}
+
+// createAlarmTemplateRules applies the template to every dimension value by
+// creating one alarm rule per item and value, and records the rule IDs.
+func createAlarmTemplateRules(d *schema.ResourceData, client *golangsdk.ServiceClient) error {
+	var ids []string
+	defer func() {
+		d.Set("alarm_ids", ids)
+	}()
+
+	name := d.Get("name").(string)
+	for i, v := range d.Get("dimension_values").([]interface{}) {
+		for j, item := range getAlarmTemplateItems(d) {
+			createOpts := alarmrule.CreateOpts{
+				AlarmName:        alarmTemplateRuleName(name, item.MetricName, j, i),
+				AlarmDescription: d.Get("description").(string),
+				Metric: alarmrule.MetricOpts{
+					Namespace:  item.Namespace,
+					MetricName: item.MetricName,
+					Dimensions: []alarmrule.DimensionOpts{
+						{
+							Name:  item.DimensionName,
+							Value: v.(string),
+						},
+					},
+				},
+				Condition: alarmrule.ConditionOpts{
+					Period:             item.Condition.Period,
+					Filter:             item.Condition.Filter,
+					ComparisonOperator: item.Condition.ComparisonOperator,
+					Value:              item.Condition.Value,
+					Unit:               item.Condition.Unit,
+					Count:              item.Condition.Count,
+				},
+				AlarmActions:            getAlarmAction(d, "alarm_actions"),
+				InsufficientdataActions: getAlarmAction(d, "insufficientdata_actions"),
+				OkActions:               getAlarmAction(d, "ok_actions"),
+				AlarmEnabled:            d.Get("alarm_enabled").(bool),
+				AlarmActionEnabled:      d.Get("alarm_action_enabled").(bool),
+				AlarmLevel:              item.AlarmLevel,
+			}
+			log.Printf("[DEBUG] Create %s Options: %#v", nameCESAR, createOpts)
+
+			var r *alarmrule.CreateResponse
+			err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+				var err error
+				r, err = alarmrule.Create(client, createOpts).Extract()
+				if err != nil {
+					return checkForRetryableError(err)
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("Error creating %s for %s: %s", nameCESAR, nameCESAT, err)
+			}
+			ids = append(ids, r.AlarmID)
+		}
+	}
+	return nil
+}
+
+// alarmTemplateRuleName returns the name of the alarm rule of an item of the
+// template for a dimension value. Items may share a metric, so the name holds
+// the position of both the item and the value.
+func alarmTemplateRuleName(name, metricName string, item, value int) string {
+	return fmt.Sprintf("%s_%s_%d_%d", name, metricName, item, value)
+}
+
+// deleteAlarmTemplateRules removes the alarm rules created from the template.
+func deleteAlarmTemplateRules(d *schema.ResourceData, client *golangsdk.ServiceClient) error {
+	ids := d.Get("alarm_ids").([]interface{})
+	for i, v := range ids {
+		arId := v.(string)
+		log.Printf("[DEBUG] Deleting %s %s", nameCESAR, arId)
+
+		err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+			err := alarmrule.Delete(client, arId).ExtractErr()
+			if err != nil {
+				return checkForRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil && !isResourceNotFound(err) {
+			d.Set("alarm_ids", ids[i:])
+			return fmt.Errorf("Error deleting %s %s: %s", nameCESAR, arId, err)
+		}
+	}
+	d.Set("alarm_ids", nil)
+	return nil
+}
+
+func resourceAlarmTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := chooseCESClient(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating Cloud Eye Service client: %s", err)
+	}
+
+	createOpts := alarmtemplate.CreateOpts{
+		TemplateName:        d.Get("name").(string),
+		TemplateDescription: d.Get("description").(string),
+		Namespace:           d.Get("namespace").(string),
+		DimensionName:       d.Get("dimension_name").(string),
+		TemplateItems:       getAlarmTemplateItems(d),
+	}
+	log.Printf("[DEBUG] Create %s Options: %#v", nameCESAT, createOpts)
+
+	r, err := alarmtemplate.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating %s: %s", nameCESAT, err)
+	}
+	log.Printf("[DEBUG] Create %s: %#v", nameCESAT, *r)
+
+	d.SetId(r.TemplateID)
+
+	if err := createAlarmTemplateRules(d, client); err != nil {
+		return err
+	}
+
+	return resourceAlarmTemplateRead(d, meta)
+}
+
+func resourceAlarmTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := chooseCESClient(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating Cloud Eye Service client: %s", err)
+	}
+
+	r, err := alarmtemplate.Get(client, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "alarm template")
+	}
+	log.Printf("[DEBUG] Retrieved %s %s: %#v", nameCESAT, d.Id(), r)
+
+	items := make([]map[string]interface{}, len(r.TemplateItems))
+	for i, item := range r.TemplateItems {
+		items[i] = map[string]interface{}{
+			"metric_name":         item.MetricName,
+			"period":              item.Condition.Period,
+			"filter":              item.Condition.Filter,
+			"comparison_operator": item.Condition.ComparisonOperator,
+			"value":               item.Condition.Value,
+			"unit":                item.Condition.Unit,
+			"count":               item.Condition.Count,
+			"alarm_level":         item.AlarmLevel,
+		}
+	}
+
+	d.Set("name", r.TemplateName)
+	d.Set("description", r.TemplateDescription)
+	d.Set("namespace", r.Namespace)
+	d.Set("dimension_name", r.DimensionName)
+	if err := d.Set("item", items); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving item to state for %s (%s): %s", nameCESAT, d.Id(), err)
+	}
+	return nil
+}
+
+func resourceAlarmTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := chooseCESClient(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating Cloud Eye Service client: %s", err)
+	}
+
+	atId := d.Id()
+
+	if d.HasChange("name") || d.HasChange("description") || d.HasChange("item") {
+		updateOpts := alarmtemplate.UpdateOpts{
+			TemplateName:        d.Get("name").(string),
+			TemplateDescription: d.Get("description").(string),
+			Namespace:           d.Get("namespace").(string),
+			DimensionName:       d.Get("dimension_name").(string),
+			TemplateItems:       getAlarmTemplateItems(d),
+		}
+		log.Printf("[DEBUG] Updating %s %s with options: %#v", nameCESAT, atId, updateOpts)
+
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		err = resource.Retry(timeout, func() *resource.RetryError {
+			err := alarmtemplate.Update(client, atId, updateOpts).ExtractErr()
+			if err != nil {
+				return checkForRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating %s %s: %s", nameCESAT, atId, err)
+		}
+	}
+
+	// alarm rules can not be changed in place, so the rules created from
+	// the template are replaced whenever any of their settings change.
+	if d.HasChange("name") || d.HasChange("description") || d.HasChange("item") ||
+		d.HasChange("dimension_values") || d.HasChange("alarm_actions") ||
+		d.HasChange("insufficientdata_actions") || d.HasChange("ok_actions") ||
+		d.HasChange("alarm_enabled") || d.HasChange("alarm_action_enabled") {
+		// keep track of the rule IDs even if replacing the rules fails.
+		d.Partial(true)
+		d.SetPartial("alarm_ids")
+		if err := deleteAlarmTemplateRules(d, client); err != nil {
+			return err
+		}
+		if err := createAlarmTemplateRules(d, client); err != nil {
+			return err
+		}
+		d.Partial(false)
+	}
+
+	return resourceAlarmTemplateRead(d, meta)
+}
+
+func resourceAlarmTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := chooseCESClient(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating Cloud Eye Service client: %s", err)
+	}
+
+	if err := deleteAlarmTemplateRules(d, client); err != nil {
+		return err
+	}
+
+	atId := d.Id()
+	log.Printf("[DEBUG] Deleting %s %s", nameCESAT, atId)
+
+	timeout := d.Timeout(schema.TimeoutDelete)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err := alarmtemplate.Delete(client, atId).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		if isResourceNotFound(err) {
+			log.Printf("[INFO] deleting an unavailable %s: %s", nameCESAT, atId)
+			return nil
+		}
+		return fmt.Errorf("Error deleting %s %s: %s", nameCESAT, atId, err)
+	}
+
+	d.SetId("")
+	return nil
+}
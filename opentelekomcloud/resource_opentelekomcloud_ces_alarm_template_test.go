@@ -0,0 +1,245 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/huaweicloud/golangsdk/openstack/cloudeyeservice/alarmrule"
+	"github.com/huaweicloud/golangsdk/openstack/cloudeyeservice/alarmtemplate"
+)
+
+func TestCESAlarmTemplate_basic(t *testing.T) {
+	var at alarmtemplate.AlarmTemplate
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCESAlarmTemplateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testCESAlarmTemplate_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testCESAlarmTemplateExists("opentelekomcloud_ces_alarm_template.template_1", &at),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ces_alarm_template.template_1", "item.#", "2"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ces_alarm_template.template_1", "alarm_ids.#", "4"),
+				),
+			},
+			resource.TestStep{
+				Config: testCESAlarmTemplate_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ces_alarm_template.template_1", "item.#", "3"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ces_alarm_template.template_1", "alarm_ids.#", "6"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_ces_alarm_template.template_1", "item.2.alarm_level", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAlarmTemplateRuleName(t *testing.T) {
+	names := map[string]bool{}
+	for item, metric := range []string{"cpu_util", "cpu_util", "mem_util"} {
+		for value := 0; value < 2; value++ {
+			name := alarmTemplateRuleName("tpl", metric, item, value)
+			if names[name] {
+				t.Fatalf("Duplicate alarm rule name %s", name)
+			}
+			names[name] = true
+		}
+	}
+
+	if got := alarmTemplateRuleName("tpl", "cpu_util", 1, 0); got != "tpl_cpu_util_1_0" {
+		t.Fatalf("Expected alarm rule name tpl_cpu_util_1_0, got %s", got)
+	}
+}
+
+func testCESAlarmTemplateDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	client, err := config.loadCESClient(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud ces client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_ces_alarm_template" {
+			continue
+		}
+
+		_, err := alarmtemplate.Get(client, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Alarm template still exists")
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if k == "alarm_ids.#" || !strings.HasPrefix(k, "alarm_ids.") {
+				continue
+			}
+			if _, err := alarmrule.Get(client, v).Extract(); err == nil {
+				return fmt.Errorf("Alarm rule %s of the alarm template still exists", v)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testCESAlarmTemplateExists(n string, at *alarmtemplate.AlarmTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		client, err := config.loadCESClient(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud ces client: %s", err)
+		}
+
+		found, err := alarmtemplate.Get(client, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		*at = *found
+
+		return nil
+	}
+}
+
+var testCESAlarmTemplate_basic = fmt.Sprintf(`
+resource "opentelekomcloud_compute_instance_v2" "vm_1" {
+  name = "instance_1"
+  network {
+    uuid = "%s"
+  }
+}
+
+resource "opentelekomcloud_compute_instance_v2" "vm_2" {
+  name = "instance_2"
+  network {
+    uuid = "%s"
+  }
+}
+
+resource "opentelekomcloud_smn_topic_v2" "topic_1" {
+  name		  = "topic_1"
+  display_name    = "The display name of topic_1"
+}
+
+resource "opentelekomcloud_ces_alarm_template" "template_1" {
+  name           = "template_1"
+  namespace      = "SYS.ECS"
+  dimension_name = "instance_id"
+
+  item {
+    metric_name         = "cpu_util"
+    period              = 300
+    filter              = "average"
+    comparison_operator = ">"
+    value               = 80
+    unit                = "%%"
+    count               = 3
+  }
+  item {
+    metric_name         = "mem_util"
+    period              = 300
+    filter              = "average"
+    comparison_operator = ">"
+    value               = 90
+    unit                = "%%"
+    count               = 3
+  }
+
+  dimension_values = [
+    "${opentelekomcloud_compute_instance_v2.vm_1.id}",
+    "${opentelekomcloud_compute_instance_v2.vm_2.id}",
+  ]
+
+  alarm_action_enabled = false
+  alarm_actions {
+    type              = "notification"
+    notification_list = ["${opentelekomcloud_smn_topic_v2.topic_1.topic_urn}"]
+  }
+}
+`, OS_NETWORK_ID, OS_NETWORK_ID)
+
+var testCESAlarmTemplate_update = fmt.Sprintf(`
+resource "opentelekomcloud_compute_instance_v2" "vm_1" {
+  name = "instance_1"
+  network {
+    uuid = "%s"
+  }
+}
+
+resource "opentelekomcloud_compute_instance_v2" "vm_2" {
+  name = "instance_2"
+  network {
+    uuid = "%s"
+  }
+}
+
+resource "opentelekomcloud_smn_topic_v2" "topic_1" {
+  name		  = "topic_1"
+  display_name    = "The display name of topic_1"
+}
+
+resource "opentelekomcloud_ces_alarm_template" "template_1" {
+  name           = "template_1"
+  description    = "cpu, memory and disk alarms"
+  namespace      = "SYS.ECS"
+  dimension_name = "instance_id"
+
+  item {
+    metric_name         = "cpu_util"
+    period              = 300
+    filter              = "average"
+    comparison_operator = ">"
+    value               = 80
+    unit                = "%%"
+    count               = 3
+  }
+  item {
+    metric_name         = "mem_util"
+    period              = 300
+    filter              = "average"
+    comparison_operator = ">"
+    value               = 90
+    unit                = "%%"
+    count               = 3
+  }
+  item {
+    metric_name         = "disk_util_inband"
+    period              = 300
+    filter              = "max"
+    comparison_operator = ">="
+    value               = 95
+    unit                = "%%"
+    count               = 1
+    alarm_level         = 1
+  }
+
+  dimension_values = [
+    "${opentelekomcloud_compute_instance_v2.vm_1.id}",
+    "${opentelekomcloud_compute_instance_v2.vm_2.id}",
+  ]
+
+  alarm_action_enabled = false
+  alarm_actions {
+    type              = "notification"
+    notification_list = ["${opentelekomcloud_smn_topic_v2.topic_1.topic_urn}"]
+  }
+}
+`, OS_NETWORK_ID, OS_NETWORK_ID)
@@ -19,6 +19,9 @@ func resourceAlarmRule() *schema.Resource {
 		Read:   resourceAlarmRuleRead,
 		Update: resourceAlarmRuleUpdate,
 		Delete: resourceAlarmRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -134,6 +137,7 @@ func resourceAlarmRule() *schema.Resource {
 							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
 								value := v.(int)
 								switch value {
+								case 0:
 								case 1:
 								case 300:
 								case 1200:
@@ -141,7 +145,7 @@ func resourceAlarmRule() *schema.Resource {
 								case 14400:
 								case 86400:
 								default:
-									errors = append(errors, fmt.Errorf("%s can be 1, 300, 1200, 3600, 14400, 86400", k))
+									errors = append(errors, fmt.Errorf("%s can be 0 (event alarms only), 1, 300, 1200, 3600, 14400, 86400", k))
 								}
 								return
 							},
@@ -317,6 +321,26 @@ func resourceAlarmRule() *schema.Resource {
 				Default:  true,
 			},
 
+			"alarm_level": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  2,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateIntRange(v, k, 1, 4)
+				},
+			},
+
+			"alarm_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"EVENT.SYS", "EVENT.CUSTOM"})
+				},
+			},
+
 			"update_time": &schema.Schema{
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -410,6 +434,8 @@ func resourceAlarmRuleCreate(d *schema.ResourceData, meta interface{}) error {
 		OkActions:               getAlarmAction(d, "ok_actions"),
 		AlarmEnabled:            d.Get("alarm_enabled").(bool),
 		AlarmActionEnabled:      d.Get("alarm_action_enabled").(bool),
+		AlarmLevel:              d.Get("alarm_level").(int),
+		AlarmType:               d.Get("alarm_type").(string),
 	}
 	log.Printf("[DEBUG] Create %s Options: %#v", nameCESAR, createOpts)
 
@@ -450,6 +476,8 @@ func resourceAlarmRuleRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("ok_actions", m["ok_actions"])
 	d.Set("alarm_enabled", m["alarm_enabled"])
 	d.Set("alarm_action_enabled", m["alarm_action_enabled"])
+	d.Set("alarm_level", m["alarm_level"])
+	d.Set("alarm_type", m["alarm_type"])
 	d.Set("update_time", m["update_time"])
 	d.Set("alarm_state", m["alarm_state"])
 	return nil
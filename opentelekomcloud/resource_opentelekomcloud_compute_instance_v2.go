@@ -16,7 +16,6 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/schedulerhints"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/secgroups"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/startstop"
-	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/tags"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/images"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
@@ -32,6 +31,9 @@ func resourceComputeInstanceV2() *schema.Resource {
 		Update: resourceComputeInstanceV2Update,
 		Delete: resourceComputeInstanceV2Delete,
 
+		SchemaVersion: 1,
+		MigrateState:  resourceComputeInstanceV2MigrateState,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
@@ -331,12 +333,8 @@ func resourceComputeInstanceV2() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
-			"tags": &schema.Schema{
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
-			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 			/* "force_delete": &schema.Schema{
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -480,13 +478,12 @@ func resourceComputeInstanceV2Create(d *schema.ResourceData, meta interface{}) e
 			server.ID, err)
 	}
 
-	taglist := resourceBuildTags(d)
-	log.Printf("[DEBUG] Setting taglist: %v", taglist)
-	if len(taglist) > 0 {
-		_, err := CreateServerTags(computeClient, d.Id(), taglist)
-		if err != nil {
-			return fmt.Errorf("Error creating tags for instance (%s): %s", d.Id(), err)
-		}
+	tagManager, err := resourceComputeInstanceV2TagManager(d, config)
+	if err != nil {
+		return err
+	}
+	if err := setResourceTags(d, meta, tagManager); err != nil {
+		return fmt.Errorf("Error creating tags for instance (%s): %s", d.Id(), err)
 	}
 
 	if hasFilledOpt(d, "auto_recovery") {
@@ -605,18 +602,13 @@ func resourceComputeInstanceV2Read(d *schema.ResourceData, meta interface{}) err
 	// Set the region
 	d.Set("region", GetRegion(d, config))
 
-	taglist, err := GetServerTags(computeClient, d.Id())
+	tagManager, err := resourceComputeInstanceV2TagManager(d, config)
 	if err != nil {
 		return err
 	}
-	d.Set("tags", taglist.Tags)
-	// Filter out network name if it is in the list
-	tagset := d.Get("tags").(*schema.Set)
-	name := GetNetworkName(d)
-	if name != "" {
-		tagset.Remove(name)
+	if err := readResourceTags(d, meta, tagManager); err != nil {
+		return fmt.Errorf("Error reading tags for instance (%s): %s", d.Id(), err)
 	}
-	d.Set("tags", tagset.List())
 
 	ar, err := resourceECSAutoRecoveryV1Read(d, meta, d.Id())
 	if err != nil && !isResourceNotFound(err) {
@@ -627,59 +619,17 @@ func resourceComputeInstanceV2Read(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func CreateServerTags(client *gophercloud.ServiceClient, server_id string, taglist []string) (*tags.Tags, error) {
-	createOpts := tags.CreateOpts{
-		Tags: taglist,
-	}
-	return tags.Create(client, server_id, createOpts).Extract()
-}
-
-func GetServerTags(client *gophercloud.ServiceClient, server_id string) (*tags.Tags, error) {
-	return tags.Get(client, server_id).Extract()
-}
-
-func DeleteServerTags(client *gophercloud.ServiceClient, server_id string) error {
-	return tags.Delete(client, server_id).ExtractErr()
-}
-
-func resourceBuildTags(d *schema.ResourceData) []string {
-	v := d.Get("tags").(*schema.Set).List()
-	var tags []string
-	/* name := GetNetworkName(d)
-	if name != "" {
-		tags = append(tags, name)
-	} */
-	for _, tag := range v {
-		tags = append(tags, tag.(string))
-	}
-
-	return tags
-}
-
-func GetNetworkName(d *schema.ResourceData) string {
-	//log.Printf("[DEBUG] GetNetworkName starting.")
-	if v, ok := d.GetOk("network"); ok {
-		//log.Printf("[DEBUG] GetNetworkName step 1.")
-		if networks, ok := v.([]interface{}); ok {
-			//log.Printf("[DEBUG] GetNetworkName step 2.")
-			if len(networks) > 0 {
-				//log.Printf("[DEBUG] GetNetworkName step 3.")
-				network := networks[0]
-				if network_map, ok := network.(map[string]interface{}); ok {
-					//log.Printf("[DEBUG] GetNetworkName step 4.")
-					if name, ok := network_map["name"]; ok {
-						//log.Printf("[DEBUG] GetNetworkName step 5.")
-						if sname, ok := name.(string); ok {
-							log.Printf("[DEBUG] GetNetworkName: %v", sname)
-							return sname
-						}
-					}
-				}
-			}
-		}
+// resourceComputeInstanceV2TagManager returns the tagManager of the ECS
+// instances, the endpoint of the ECS service already contains the project ID.
+func resourceComputeInstanceV2TagManager(d *schema.ResourceData, config *Config) (*tagManager, error) {
+	client, err := chooseECSV1Client(d, config)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating OpenTelekomCloud ECS client: %s", err)
 	}
-	log.Printf("[DEBUG] GetNetworkName missing.")
-	return ""
+	return &tagManager{
+		client:  client,
+		srvType: "cloudservers",
+	}, nil
 }
 
 func resourceComputeInstanceV2Update(d *schema.ResourceData, meta interface{}) error {
@@ -842,20 +792,13 @@ func resourceComputeInstanceV2Update(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	if d.HasChange("tags") {
-		taglist := resourceBuildTags(d)
-		log.Printf("[DEBUG] Setting tags to %v", taglist)
-
-		if len(taglist) == 0 {
-			err := DeleteServerTags(computeClient, d.Id())
-			if err != nil {
-				return fmt.Errorf("Error deleting tags for instance (%s): %s", d.Id(), err)
-			}
-		} else {
-			_, err := CreateServerTags(computeClient, d.Id(), taglist)
-			if err != nil {
-				return fmt.Errorf("Error creating tags for instance (%s): %s", d.Id(), err)
-			}
+	if resourceTagsChanged(d, meta) {
+		tagManager, err := resourceComputeInstanceV2TagManager(d, config)
+		if err != nil {
+			return err
+		}
+		if err := setResourceTags(d, meta, tagManager); err != nil {
+			return fmt.Errorf("Error updating tags for instance (%s): %s", d.Id(), err)
 		}
 	}
 
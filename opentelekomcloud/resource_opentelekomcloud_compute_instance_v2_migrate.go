@@ -0,0 +1,60 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func resourceComputeInstanceV2MigrateState(
+	v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	switch v {
+	case 0:
+		log.Println("[INFO] Found Compute Instance V2 State v0; migrating to v1")
+		return migrateComputeInstanceV2StateV0toV1(is)
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+}
+
+// migrateComputeInstanceV2StateV0toV1 turns the string tags of the Nova tags
+// extension into key/value tags. A "key.value" tag becomes the tag key with
+// the value value, any other tag a key with an empty value.
+func migrateComputeInstanceV2StateV0toV1(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+	if is.Empty() || is.Attributes == nil {
+		log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
+		return is, nil
+	}
+
+	log.Printf("[DEBUG] Attributes before migration: %#v", is.Attributes)
+
+	tags := make(map[string]string)
+	for k, v := range is.Attributes {
+		if !strings.HasPrefix(k, "tags.") {
+			continue
+		}
+		delete(is.Attributes, k)
+		if k == "tags.#" {
+			continue
+		}
+
+		parts := strings.SplitN(v, ".", 2)
+		if len(parts) == 2 {
+			tags[parts[0]] = parts[1]
+		} else {
+			tags[v] = ""
+		}
+	}
+
+	if len(tags) > 0 {
+		is.Attributes["tags.%"] = fmt.Sprintf("%d", len(tags))
+		for k, v := range tags {
+			is.Attributes["tags."+k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Attributes after migration: %#v", is.Attributes)
+	return is, nil
+}
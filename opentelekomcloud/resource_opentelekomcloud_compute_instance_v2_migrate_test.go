@@ -0,0 +1,89 @@
+package opentelekomcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestComputeInstanceV2MigrateState(t *testing.T) {
+	cases := map[string]struct {
+		StateVersion int
+		Attributes   map[string]string
+		Expected     map[string]string
+	}{
+		"v0_1 with tags": {
+			StateVersion: 0,
+			Attributes: map[string]string{
+				"name":              "instance_1",
+				"tags.#":            "3",
+				"tags.1012461226":   "foo.bar",
+				"tags.2591785441":   "key.value.with.dots",
+				"tags.3764939291":   "web",
+				"metadata.%":        "1",
+				"metadata.foo":      "bar",
+				"security_groups.#": "0",
+			},
+			Expected: map[string]string{
+				"name":              "instance_1",
+				"tags.%":            "3",
+				"tags.foo":          "bar",
+				"tags.key":          "value.with.dots",
+				"tags.web":          "",
+				"metadata.%":        "1",
+				"metadata.foo":      "bar",
+				"security_groups.#": "0",
+			},
+		},
+		"v0_1 without tags": {
+			StateVersion: 0,
+			Attributes: map[string]string{
+				"name":   "instance_1",
+				"tags.#": "0",
+			},
+			Expected: map[string]string{
+				"name": "instance_1",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		is := &terraform.InstanceState{
+			ID:         "i-abc123",
+			Attributes: tc.Attributes,
+		}
+		is, err := resourceComputeInstanceV2MigrateState(tc.StateVersion, is, nil)
+		if err != nil {
+			t.Fatalf("bad: %s, err: %#v", tn, err)
+		}
+
+		if len(is.Attributes) != len(tc.Expected) {
+			t.Fatalf("bad: %s\n\n expected: %#v -> %#v\n got: %#v", tn, tc.Attributes, tc.Expected, is.Attributes)
+		}
+		for k, v := range tc.Expected {
+			if is.Attributes[k] != v {
+				t.Fatalf("bad: %s\n\n expected: %#v -> %#v\n got: %#v", tn, k, v, is.Attributes[k])
+			}
+		}
+	}
+}
+
+func TestComputeInstanceV2MigrateState_empty(t *testing.T) {
+	var is *terraform.InstanceState
+	var meta interface{}
+
+	// should handle nil
+	is, err := resourceComputeInstanceV2MigrateState(0, is, meta)
+	if err != nil {
+		t.Fatalf("err: %#v", err)
+	}
+	if is != nil {
+		t.Fatalf("expected nil instancestate, got: %#v", is)
+	}
+
+	// should handle non-nil but empty
+	is = &terraform.InstanceState{}
+	if _, err := resourceComputeInstanceV2MigrateState(0, is, meta); err != nil {
+		t.Fatalf("err: %#v", err)
+	}
+}
@@ -2,6 +2,7 @@ package opentelekomcloud
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -53,14 +54,18 @@ func TestAccComputeV2Instance_tags(t *testing.T) {
 				Config: testAccComputeV2Instance_tags,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeV2InstanceExists("opentelekomcloud_compute_instance_v2.instance_1", &instance),
-					testAccCheckComputeV2InstanceTags(&instance, "foo.bar", "key.value"),
+					testAccCheckComputeV2InstanceTags(&instance, map[string]string{"foo": "bar", "key": "value"}),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_compute_instance_v2.instance_1", "tags.foo", "bar"),
 				),
 			},
 			resource.TestStep{
 				Config: testAccComputeV2Instance_tags2,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeV2InstanceExists("opentelekomcloud_compute_instance_v2.instance_1", &instance),
-					testAccCheckComputeV2InstanceTags(&instance, "foo2.bar2", "key2.value2"),
+					testAccCheckComputeV2InstanceTags(&instance, map[string]string{"foo2": "bar2", "key2": "value2"}),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_compute_instance_v2.instance_1", "tags.%", "2"),
 				),
 			},
 			resource.TestStep{
@@ -463,33 +468,15 @@ func testAccCheckComputeV2InstanceNoMetadataKey(
 }
 
 func testAccCheckComputeV2InstanceTags(
-	instance *servers.Server, tag1, tag2 string) resource.TestCheckFunc {
+	instance *servers.Server, expected map[string]string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		config := testAccProvider.Meta().(*Config)
-		computeClient, err := config.computeV2Client(OS_REGION_NAME)
-		if err != nil {
-			return err
-		}
-
-		taglist2, err := GetServerTags(computeClient, instance.ID)
+		tags, err := testAccComputeV2InstanceTags(instance)
 		if err != nil {
 			return err
 		}
 
-		// Hack check for tags, need to do set equality and filter out network name...
-		tagmap := map[string]bool{}
-		for _, tag := range taglist2.Tags {
-			tagmap[tag] = true
-		}
-
-		if !tagmap[tag1] {
-			return fmt.Errorf("Missing tag %s", tag1)
-		}
-		if !tagmap[tag2] {
-			return fmt.Errorf("Missing tag %s", tag1)
-		}
-		if len(taglist2.Tags) < 2 || len(taglist2.Tags) > 3 {
-			return fmt.Errorf("Tags length not correct: %d", len(taglist2.Tags))
+		if !reflect.DeepEqual(tags, expected) {
+			return fmt.Errorf("Expected tags %v, but found %v", expected, tags)
 		}
 
 		return nil
@@ -499,26 +486,31 @@ func testAccCheckComputeV2InstanceTags(
 func testAccCheckComputeV2InstanceNoTags(
 	instance *servers.Server) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		config := testAccProvider.Meta().(*Config)
-		computeClient, err := config.computeV2Client(OS_REGION_NAME)
+		tags, err := testAccComputeV2InstanceTags(instance)
 		if err != nil {
 			return err
 		}
 
-		taglist2, err := GetServerTags(computeClient, instance.ID)
-		if err != nil {
-			return err
+		if len(tags) != 0 {
+			return fmt.Errorf("Expected no tags, but found %v", tags)
 		}
 
-		if taglist2.Tags == nil {
-			return nil
-		}
-		if len(taglist2.Tags) == 0 {
-			return nil
-		}
+		return nil
+	}
+}
 
-		return fmt.Errorf("Expected no tags, but found %v", taglist2.Tags)
+func testAccComputeV2InstanceTags(instance *servers.Server) (map[string]string, error) {
+	config := testAccProvider.Meta().(*Config)
+	client, err := config.loadECSV1Client(OS_REGION_NAME)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &tagManager{
+		client:  client,
+		srvType: "cloudservers",
 	}
+	return m.get(instance.ID)
 }
 
 func testAccCheckComputeV2InstanceBootVolumeAttachment(
@@ -588,7 +580,10 @@ resource "opentelekomcloud_compute_instance_v2" "instance_1" {
   network {
     uuid = "%s"
   }
-  tags = ["foo.bar", "key.value"]
+  tags {
+    foo = "bar"
+    key = "value"
+  }
 }
 `, OS_AVAILABILITY_ZONE, OS_NETWORK_ID)
 
@@ -603,7 +598,10 @@ resource "opentelekomcloud_compute_instance_v2" "instance_1" {
   network {
     uuid = "%s"
   }
-  tags = ["foo2.bar2", "key2.value2"]
+  tags {
+    foo2 = "bar2"
+    key2 = "value2"
+  }
 }
 `, OS_AVAILABILITY_ZONE, OS_NETWORK_ID)
 
@@ -0,0 +1,150 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk/openstack/cts/v1/tracker"
+)
+
+func resourceCTSKeyEventNotificationV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCTSKeyEventNotificationV1Create,
+		Read:   resourceCTSKeyEventNotificationV1Read,
+		Update: resourceCTSKeyEventNotificationV1Update,
+		Delete: resourceCTSKeyEventNotificationV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"tracker_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"topic_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"operations": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"is_send_all_key_operation": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"need_notify_user_list": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 50,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+// updateCTSKeyEventNotificationV1 replaces the notification settings of
+// a tracker, keeping all of its other settings.
+func updateCTSKeyEventNotificationV1(d *schema.ResourceData, meta interface{}, smn *tracker.Smn) error {
+	config := meta.(*Config)
+	ctsClient, err := config.ctsV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud cts client: %s", err)
+	}
+
+	trackerName := d.Get("tracker_name").(string)
+	v, err := tracker.Get(ctsClient, trackerName).Extract()
+	if err != nil {
+		return err
+	}
+
+	updateOpts := tracker.UpdateOpts{
+		BucketName: v.BucketName,
+		Smn:        smn,
+	}
+	log.Printf("[DEBUG] Updating cts tracker %s with options: %#v", trackerName, updateOpts)
+	return tracker.Update(ctsClient, trackerName, updateOpts).Err
+}
+
+func getCTSKeyEventNotificationV1(d *schema.ResourceData) *tracker.Smn {
+	var operations []string
+	for _, op := range d.Get("operations").(*schema.Set).List() {
+		operations = append(operations, op.(string))
+	}
+	var users []string
+	for _, user := range d.Get("need_notify_user_list").(*schema.Set).List() {
+		users = append(users, user.(string))
+	}
+
+	return &tracker.Smn{
+		IsSupportSMN:          true,
+		TopicID:               d.Get("topic_id").(string),
+		Operations:            operations,
+		IsSendAllKeyOperation: d.Get("is_send_all_key_operation").(bool),
+		NeedNotifyUserList:    users,
+	}
+}
+
+func resourceCTSKeyEventNotificationV1Create(d *schema.ResourceData, meta interface{}) error {
+	err := updateCTSKeyEventNotificationV1(d, meta, getCTSKeyEventNotificationV1(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud cts key event notification: %s", err)
+	}
+
+	d.SetId(d.Get("tracker_name").(string))
+
+	return resourceCTSKeyEventNotificationV1Read(d, meta)
+}
+
+func resourceCTSKeyEventNotificationV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctsClient, err := config.ctsV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud cts client: %s", err)
+	}
+
+	v, err := tracker.Get(ctsClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "cts key event notification")
+	}
+	if !v.Smn.IsSupportSMN {
+		log.Printf("[WARN] Removing cts key event notification %s because it's disabled", d.Id())
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Cts key event notification %s: %+v", d.Id(), v.Smn)
+
+	d.Set("tracker_name", v.TrackerName)
+	d.Set("topic_id", v.Smn.TopicID)
+	d.Set("operations", v.Smn.Operations)
+	d.Set("is_send_all_key_operation", v.Smn.IsSendAllKeyOperation)
+	d.Set("need_notify_user_list", v.Smn.NeedNotifyUserList)
+
+	return nil
+}
+
+func resourceCTSKeyEventNotificationV1Update(d *schema.ResourceData, meta interface{}) error {
+	err := updateCTSKeyEventNotificationV1(d, meta, getCTSKeyEventNotificationV1(d))
+	if err != nil {
+		return fmt.Errorf("Error updating OpenTelekomCloud cts key event notification: %s", err)
+	}
+
+	return resourceCTSKeyEventNotificationV1Read(d, meta)
+}
+
+func resourceCTSKeyEventNotificationV1Delete(d *schema.ResourceData, meta interface{}) error {
+	err := updateCTSKeyEventNotificationV1(d, meta, &tracker.Smn{IsSupportSMN: false})
+	if err != nil {
+		return CheckDeleted(d, err, "cts key event notification")
+	}
+
+	log.Printf("[DEBUG] Cts key event notification %s disabled", d.Id())
+	d.SetId("")
+	return nil
+}
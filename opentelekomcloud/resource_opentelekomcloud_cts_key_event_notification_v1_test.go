@@ -0,0 +1,107 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/huaweicloud/golangsdk/openstack/cts/v1/tracker"
+)
+
+func TestAccCTSKeyEventNotificationV1_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCTSKeyEventNotificationV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCTSKeyEventNotificationV1_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"opentelekomcloud_cts_key_event_notification_v1.notification_1", "topic_id",
+						"opentelekomcloud_smn_topic_v2.topic_1", "topic_urn"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_cts_key_event_notification_v1.notification_1", "operations.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCTSKeyEventNotificationV1_update(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_cts_key_event_notification_v1.notification_1", "is_send_all_key_operation", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCTSKeyEventNotificationV1Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	ctsClient, err := config.ctsV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud cts client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_cts_key_event_notification_v1" {
+			continue
+		}
+
+		v, err := tracker.Get(ctsClient, rs.Primary.ID).Extract()
+		if err == nil && v.Smn.IsSupportSMN {
+			return fmt.Errorf("Cts key event notification still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCTSKeyEventNotificationV1_basic(rInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_s3_bucket" "bucket_1" {
+  bucket        = "tf-cts-test-bucket-%d"
+  force_destroy = true
+}
+
+resource "opentelekomcloud_smn_topic_v2" "topic_1" {
+  name = "cts_topic_%d"
+}
+
+resource "opentelekomcloud_cts_tracker_v1" "tracker_1" {
+  bucket_name = "${opentelekomcloud_s3_bucket.bucket_1.bucket}"
+}
+
+resource "opentelekomcloud_cts_key_event_notification_v1" "notification_1" {
+  tracker_name = "${opentelekomcloud_cts_tracker_v1.tracker_1.tracker_name}"
+  topic_id     = "${opentelekomcloud_smn_topic_v2.topic_1.topic_urn}"
+  operations   = ["deleteServer", "deleteVpc"]
+}
+`, rInt, rInt)
+}
+
+func testAccCTSKeyEventNotificationV1_update(rInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_s3_bucket" "bucket_1" {
+  bucket        = "tf-cts-test-bucket-%d"
+  force_destroy = true
+}
+
+resource "opentelekomcloud_smn_topic_v2" "topic_1" {
+  name = "cts_topic_%d"
+}
+
+resource "opentelekomcloud_cts_tracker_v1" "tracker_1" {
+  bucket_name = "${opentelekomcloud_s3_bucket.bucket_1.bucket}"
+}
+
+resource "opentelekomcloud_cts_key_event_notification_v1" "notification_1" {
+  tracker_name              = "${opentelekomcloud_cts_tracker_v1.tracker_1.tracker_name}"
+  topic_id                  = "${opentelekomcloud_smn_topic_v2.topic_1.topic_urn}"
+  is_send_all_key_operation = true
+}
+`, rInt, rInt)
+}
@@ -0,0 +1,187 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk/openstack/cts/v1/tracker"
+)
+
+func resourceCTSTrackerV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCTSTrackerV1Create,
+		Read:   resourceCTSTrackerV1Read,
+		Update: resourceCTSTrackerV1Update,
+		Delete: resourceCTSTrackerV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"file_prefix_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					vv := regexp.MustCompile("^[a-zA-Z0-9._-]{0,64}$")
+					if !vv.MatchString(value) {
+						errors = append(errors, fmt.Errorf("%s must be a string of 0 to 64 characters that consists of letters, digits, periods(.), underscores(_) and hyphens(-)", k))
+					}
+					return
+				},
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "enabled",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"enabled", "disabled"})
+				},
+			},
+			"is_support_trace_files_encryption": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"kms_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"is_lts_enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"tracker_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"log_group_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"log_topic_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCTSTrackerV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctsClient, err := config.ctsV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud cts client: %s", err)
+	}
+
+	createOpts := tracker.CreateOpts{
+		BucketName:                    d.Get("bucket_name").(string),
+		FilePrefixName:                d.Get("file_prefix_name").(string),
+		IsSupportTraceFilesEncryption: d.Get("is_support_trace_files_encryption").(bool),
+		KmsID:                         d.Get("kms_id").(string),
+		Lts: &tracker.Lts{
+			IsLtsEnabled: d.Get("is_lts_enabled").(bool),
+		},
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	v, err := tracker.Create(ctsClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud cts tracker: %s", err)
+	}
+	log.Printf("[INFO] Tracker name: %s", v.TrackerName)
+
+	d.SetId(v.TrackerName)
+
+	// a new tracker is always enabled
+	if d.Get("status").(string) != "enabled" {
+		updateOpts := tracker.UpdateOpts{
+			BucketName: d.Get("bucket_name").(string),
+			Status:     d.Get("status").(string),
+		}
+		err = tracker.Update(ctsClient, d.Id(), updateOpts).Err
+		if err != nil {
+			return fmt.Errorf("Error disabling OpenTelekomCloud cts tracker: %s", err)
+		}
+	}
+
+	return resourceCTSTrackerV1Read(d, meta)
+}
+
+func resourceCTSTrackerV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctsClient, err := config.ctsV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud cts client: %s", err)
+	}
+
+	v, err := tracker.Get(ctsClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "cts tracker")
+	}
+	log.Printf("[DEBUG] Cts tracker %s: %+v", d.Id(), v)
+
+	d.Set("tracker_name", v.TrackerName)
+	d.Set("bucket_name", v.BucketName)
+	d.Set("file_prefix_name", v.FilePrefixName)
+	d.Set("status", v.Status)
+	d.Set("is_support_trace_files_encryption", v.IsSupportTraceFilesEncryption)
+	d.Set("kms_id", v.KmsID)
+	d.Set("is_lts_enabled", v.Lts.IsLtsEnabled)
+	d.Set("log_group_name", v.Lts.LogGroupName)
+	d.Set("log_topic_name", v.Lts.LogTopicName)
+
+	return nil
+}
+
+func resourceCTSTrackerV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctsClient, err := config.ctsV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud cts client: %s", err)
+	}
+
+	filePrefixName := d.Get("file_prefix_name").(string)
+	encryption := d.Get("is_support_trace_files_encryption").(bool)
+	kmsID := d.Get("kms_id").(string)
+	updateOpts := tracker.UpdateOpts{
+		BucketName:                    d.Get("bucket_name").(string),
+		FilePrefixName:                &filePrefixName,
+		Status:                        d.Get("status").(string),
+		IsSupportTraceFilesEncryption: &encryption,
+		KmsID:                         &kmsID,
+		Lts: &tracker.Lts{
+			IsLtsEnabled: d.Get("is_lts_enabled").(bool),
+		},
+	}
+
+	log.Printf("[DEBUG] Updating cts tracker %s with options: %#v", d.Id(), updateOpts)
+	err = tracker.Update(ctsClient, d.Id(), updateOpts).Err
+	if err != nil {
+		return fmt.Errorf("Error updating OpenTelekomCloud cts tracker: %s", err)
+	}
+
+	return resourceCTSTrackerV1Read(d, meta)
+}
+
+func resourceCTSTrackerV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctsClient, err := config.ctsV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud cts client: %s", err)
+	}
+
+	err = tracker.Delete(ctsClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "cts tracker")
+	}
+
+	log.Printf("[DEBUG] Cts tracker %s deleted", d.Id())
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,151 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/huaweicloud/golangsdk/openstack/cts/v1/tracker"
+)
+
+func TestAccCTSTrackerV1_basic(t *testing.T) {
+	var tr tracker.Tracker
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCTSTrackerV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCTSTrackerV1_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCTSTrackerV1Exists("opentelekomcloud_cts_tracker_v1.tracker_1", &tr),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_cts_tracker_v1.tracker_1", "tracker_name", "system"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_cts_tracker_v1.tracker_1", "file_prefix_name", "audit"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_cts_tracker_v1.tracker_1", "status", "enabled"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCTSTrackerV1_update(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_cts_tracker_v1.tracker_1", "file_prefix_name", "audit-log"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_cts_tracker_v1.tracker_1", "is_lts_enabled", "true"),
+					resource.TestCheckResourceAttrSet(
+						"opentelekomcloud_cts_tracker_v1.tracker_1", "log_group_name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCTSTrackerV1_importBasic(t *testing.T) {
+	resourceName := "opentelekomcloud_cts_tracker_v1.tracker_1"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCTSTrackerV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCTSTrackerV1_basic(rInt),
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCTSTrackerV1Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	ctsClient, err := config.ctsV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud cts client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_cts_tracker_v1" {
+			continue
+		}
+
+		_, err := tracker.Get(ctsClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Cts tracker still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckCTSTrackerV1Exists(n string, tr *tracker.Tracker) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		ctsClient, err := config.ctsV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud cts client: %s", err)
+		}
+
+		found, err := tracker.Get(ctsClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.TrackerName != rs.Primary.ID {
+			return fmt.Errorf("Cts tracker not found")
+		}
+
+		*tr = *found
+
+		return nil
+	}
+}
+
+func testAccCTSTrackerV1_basic(rInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_s3_bucket" "bucket_1" {
+  bucket        = "tf-cts-test-bucket-%d"
+  force_destroy = true
+}
+
+resource "opentelekomcloud_cts_tracker_v1" "tracker_1" {
+  bucket_name      = "${opentelekomcloud_s3_bucket.bucket_1.bucket}"
+  file_prefix_name = "audit"
+}
+`, rInt)
+}
+
+func testAccCTSTrackerV1_update(rInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_s3_bucket" "bucket_1" {
+  bucket        = "tf-cts-test-bucket-%d"
+  force_destroy = true
+}
+
+resource "opentelekomcloud_cts_tracker_v1" "tracker_1" {
+  bucket_name      = "${opentelekomcloud_s3_bucket.bucket_1.bucket}"
+  file_prefix_name = "audit-log"
+  is_lts_enabled   = true
+}
+`, rInt)
+}
@@ -0,0 +1,222 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/dns/v2/ptrrecords"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceDNSPtrRecordV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDNSPtrRecordV2Create,
+		Read:   resourceDNSPtrRecordV2Read,
+		Update: resourceDNSPtrRecordV2Update,
+		Delete: resourceDNSPtrRecordV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"floatingip_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if len(value) > 255 {
+						errors = append(errors, fmt.Errorf("The length of %s must be in [0, 255]", k))
+					}
+					return
+				},
+			},
+			"ttl": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  300,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateIntRange(v, k, 300, 2147483647)
+				},
+			},
+			"address": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// createDNSPtrRecordV2 sets the ptr of the floating IP and waits for it to
+// become active. The same call is used for creating and updating a ptr.
+func createDNSPtrRecordV2(d *schema.ResourceData, dnsClient *golangsdk.ServiceClient, region string, timeout time.Duration) (*ptrrecords.Ptr, error) {
+	createOpts := ptrrecords.CreateOpts{
+		PtrName:     d.Get("name").(string),
+		Description: d.Get("description").(string),
+		TTL:         d.Get("ttl").(int),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	n, err := ptrrecords.Create(dnsClient, region, d.Get("floatingip_id").(string), createOpts).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] Waiting for DNS PTR record (%s) to become available", n.ID)
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"ACTIVE"},
+		Pending:    []string{"PENDING"},
+		Refresh:    waitForDNSPtrRecord(dnsClient, n.ID),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return nil, fmt.Errorf(
+			"Error waiting for DNS PTR record (%s) to become ACTIVE: %s",
+			n.ID, err)
+	}
+
+	return n, nil
+}
+
+func resourceDNSPtrRecordV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	dnsClient, err := config.dnsV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud DNS client: %s", err)
+	}
+
+	n, err := createDNSPtrRecordV2(d, dnsClient, region, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud DNS PTR record: %s", err)
+	}
+
+	d.SetId(n.ID)
+
+	log.Printf("[DEBUG] Created OpenTelekomCloud DNS PTR record %s: %#v", n.ID, n)
+	return resourceDNSPtrRecordV2Read(d, meta)
+}
+
+func resourceDNSPtrRecordV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud DNS client: %s", err)
+	}
+
+	n, err := ptrrecords.Get(dnsClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "ptr_record")
+	}
+
+	log.Printf("[DEBUG] Retrieved PTR record %s: %#v", d.Id(), n)
+
+	// the ID is made of the region and the floating IP ID
+	parts := strings.SplitN(d.Id(), ":", 2)
+	if len(parts) != 2 {
+		return fmt.Errorf("Invalid format of the OpenTelekomCloud DNS PTR record ID %s. Format must be <region>:<floatingip id>", d.Id())
+	}
+
+	d.Set("name", n.PtrName)
+	d.Set("description", n.Description)
+	d.Set("ttl", n.TTL)
+	d.Set("address", n.Address)
+	d.Set("floatingip_id", parts[1])
+	d.Set("region", parts[0])
+
+	return nil
+}
+
+func resourceDNSPtrRecordV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	dnsClient, err := config.dnsV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud DNS client: %s", err)
+	}
+
+	_, err = createDNSPtrRecordV2(d, dnsClient, region, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("Error updating OpenTelekomCloud DNS PTR record: %s", err)
+	}
+
+	return resourceDNSPtrRecordV2Read(d, meta)
+}
+
+func resourceDNSPtrRecordV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud DNS client: %s", err)
+	}
+
+	err = ptrrecords.Delete(dnsClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "ptr_record")
+	}
+
+	log.Printf("[DEBUG] Waiting for DNS PTR record (%s) to be reset", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"DELETED"},
+		Pending:    []string{"ACTIVE", "PENDING", "ERROR"},
+		Refresh:    waitForDNSPtrRecord(dnsClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for DNS PTR record (%s) to be reset: %s",
+			d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForDNSPtrRecord(dnsClient *golangsdk.ServiceClient, ptrId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		ptr, err := ptrrecords.Get(dnsClient, ptrId).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return ptr, "DELETED", nil
+			}
+
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] OpenTelekomCloud DNS PTR record (%s) current status: %s", ptr.ID, ptr.Status)
+		return ptr, parseStatus(ptr.Status), nil
+	}
+}
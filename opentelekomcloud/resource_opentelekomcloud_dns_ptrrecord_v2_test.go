@@ -0,0 +1,144 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/huaweicloud/golangsdk/openstack/dns/v2/ptrrecords"
+)
+
+func TestAccDNSV2PtrRecord_basic(t *testing.T) {
+	var ptr ptrrecords.Ptr
+	ptrName := fmt.Sprintf("acpttest%s.com.", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDNS(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSV2PtrRecordDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDNSV2PtrRecord_basic(ptrName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2PtrRecordExists("opentelekomcloud_dns_ptrrecord_v2.ptr_1", &ptr),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_dns_ptrrecord_v2.ptr_1", "description", "a ptr record"),
+					resource.TestCheckResourceAttrPair(
+						"opentelekomcloud_dns_ptrrecord_v2.ptr_1", "address",
+						"opentelekomcloud_vpc_eip_v1.eip_1", "publicip.0.ip_address"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDNSV2PtrRecord_update(ptrName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_dns_ptrrecord_v2.ptr_1", "description", "ptr record updated"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_dns_ptrrecord_v2.ptr_1", "ttl", "6000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDNSV2PtrRecordDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	dnsClient, err := config.dnsV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud DNS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_dns_ptrrecord_v2" {
+			continue
+		}
+
+		_, err := ptrrecords.Get(dnsClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("PTR record still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDNSV2PtrRecordExists(n string, ptr *ptrrecords.Ptr) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		dnsClient, err := config.dnsV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud DNS client: %s", err)
+		}
+
+		found, err := ptrrecords.Get(dnsClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("PTR record not found")
+		}
+
+		*ptr = *found
+
+		return nil
+	}
+}
+
+func testAccDNSV2PtrRecord_basic(ptrName string) string {
+	return fmt.Sprintf(`
+		resource "opentelekomcloud_vpc_eip_v1" "eip_1" {
+			publicip {
+				type = "5_bgp"
+			}
+			bandwidth {
+				name = "test"
+				size = 8
+				share_type = "PER"
+				charge_mode = "traffic"
+			}
+		}
+
+		resource "opentelekomcloud_dns_ptrrecord_v2" "ptr_1" {
+			name = "%s"
+			description = "a ptr record"
+			floatingip_id = "${opentelekomcloud_vpc_eip_v1.eip_1.id}"
+			ttl = 3000
+		}
+	`, ptrName)
+}
+
+func testAccDNSV2PtrRecord_update(ptrName string) string {
+	return fmt.Sprintf(`
+		resource "opentelekomcloud_vpc_eip_v1" "eip_1" {
+			publicip {
+				type = "5_bgp"
+			}
+			bandwidth {
+				name = "test"
+				size = 8
+				share_type = "PER"
+				charge_mode = "traffic"
+			}
+		}
+
+		resource "opentelekomcloud_dns_ptrrecord_v2" "ptr_1" {
+			name = "%s"
+			description = "ptr record updated"
+			floatingip_id = "${opentelekomcloud_vpc_eip_v1.eip_1.id}"
+			ttl = 6000
+		}
+	`, ptrName)
+}
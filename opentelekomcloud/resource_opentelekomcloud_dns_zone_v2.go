@@ -63,7 +63,6 @@ func resourceDNSZoneV2() *schema.Resource {
 			"router": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"router_id": {
@@ -87,6 +86,8 @@ func resourceDNSZoneV2() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -109,6 +110,38 @@ func resourceDNSRouter(d *schema.ResourceData) map[string]string {
 	return nil
 }
 
+func resourceDNSZoneV2Routers(routers []interface{}) []zones.RouterOpts {
+	opts := make([]zones.RouterOpts, 0, len(routers))
+	for _, r := range routers {
+		c := r.(map[string]interface{})
+		opts = append(opts, zones.RouterOpts{
+			RouterID:     c["router_id"].(string),
+			RouterRegion: c["router_region"].(string),
+		})
+	}
+	return opts
+}
+
+// resourceDNSZoneV2TagManager returns the tagManager of the public or the
+// private zones.
+func resourceDNSZoneV2TagManager(dnsClient *golangsdk.ServiceClient, zoneType string) *tagManager {
+	return newProjectTagManager(dnsClient, fmt.Sprintf("DNS-%s_zone", zoneType))
+}
+
+func resourceDNSZoneV2WaitForActive(dnsClient *golangsdk.ServiceClient, zoneID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"ACTIVE"},
+		Pending:    []string{"PENDING"},
+		Refresh:    waitForDNSZone(dnsClient, zoneID),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
 func resourceDNSZoneV2Create(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
@@ -165,6 +198,33 @@ func resourceDNSZoneV2Create(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(n.ID)
 
+	// The zone is created with the first router only, associate the others
+	if zone_type == "private" && len(router) > 1 {
+		first := resourceDNSRouter(d)
+		for _, opts := range resourceDNSZoneV2Routers(router) {
+			if opts.RouterID == first["router_id"] && opts.RouterRegion == first["router_region"] {
+				continue
+			}
+			log.Printf("[DEBUG] Associating DNS Zone (%s) with router: %#v", n.ID, opts)
+			_, err = zones.AssociateZone(dnsClient, n.ID, opts).Extract()
+			if err != nil {
+				return fmt.Errorf("Error associating OpenTelekomCloud DNS Zone (%s) with router (%s): %s", n.ID, opts.RouterID, err)
+			}
+			if err = resourceDNSZoneV2WaitForActive(dnsClient, n.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return fmt.Errorf(
+					"Error waiting for DNS Zone (%s) to become ACTIVE: %s",
+					n.ID, err)
+			}
+		}
+	}
+
+	if len(resourceTags(d, meta)) > 0 {
+		tagManager := resourceDNSZoneV2TagManager(dnsClient, zone_type)
+		if err := setResourceTags(d, meta, tagManager); err != nil {
+			return fmt.Errorf("Error setting tags of OpenTelekomCloud DNS Zone (%s): %s", n.ID, err)
+		}
+	}
+
 	log.Printf("[DEBUG] Created OpenTelekomCloud DNS Zone %s: %#v", n.ID, n)
 	return resourceDNSZoneV2Read(d, meta)
 }
@@ -191,8 +251,25 @@ func resourceDNSZoneV2Read(d *schema.ResourceData, meta interface{}) error {
 	if err = d.Set("masters", n.Masters); err != nil {
 		return fmt.Errorf("[DEBUG] Error saving masters to state for OpenTelekomCloud DNS zone (%s): %s", d.Id(), err)
 	}
+	if n.ZoneType == "private" {
+		routers := make([]map[string]interface{}, 0, len(n.Routers))
+		for _, r := range n.Routers {
+			routers = append(routers, map[string]interface{}{
+				"router_id":     r.RouterID,
+				"router_region": r.RouterRegion,
+			})
+		}
+		if err = d.Set("router", routers); err != nil {
+			return fmt.Errorf("[DEBUG] Error saving router to state for OpenTelekomCloud DNS zone (%s): %s", d.Id(), err)
+		}
+	}
 	d.Set("region", GetRegion(d, config))
 
+	tagManager := resourceDNSZoneV2TagManager(dnsClient, n.ZoneType)
+	if err := readResourceTags(d, meta, tagManager); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving tags to state for OpenTelekomCloud DNS zone (%s): %s", d.Id(), err)
+	}
+
 	return nil
 }
 
@@ -203,35 +280,75 @@ func resourceDNSZoneV2Update(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenTelekomCloud DNS client: %s", err)
 	}
 
-	var updateOpts zones.UpdateOpts
-	if d.HasChange("email") {
-		updateOpts.Email = d.Get("email").(string)
-	}
-	if d.HasChange("ttl") {
-		updateOpts.TTL = d.Get("ttl").(int)
-	}
-	if d.HasChange("description") {
-		updateOpts.Description = d.Get("description").(string)
-	}
+	if d.HasChange("email") || d.HasChange("ttl") || d.HasChange("description") {
+		var updateOpts zones.UpdateOpts
+		if d.HasChange("email") {
+			updateOpts.Email = d.Get("email").(string)
+		}
+		if d.HasChange("ttl") {
+			updateOpts.TTL = d.Get("ttl").(int)
+		}
+		if d.HasChange("description") {
+			updateOpts.Description = d.Get("description").(string)
+		}
 
-	log.Printf("[DEBUG] Updating Zone %s with options: %#v", d.Id(), updateOpts)
+		log.Printf("[DEBUG] Updating Zone %s with options: %#v", d.Id(), updateOpts)
 
-	_, err = zones.Update(dnsClient, d.Id(), updateOpts).Extract()
-	if err != nil {
-		return fmt.Errorf("Error updating OpenTelekomCloud DNS Zone: %s", err)
+		_, err = zones.Update(dnsClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenTelekomCloud DNS Zone: %s", err)
+		}
+
+		log.Printf("[DEBUG] Waiting for DNS Zone (%s) to update", d.Id())
+		if err = resourceDNSZoneV2WaitForActive(dnsClient, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf(
+				"Error waiting for DNS Zone (%s) to become ACTIVE: %s",
+				d.Id(), err)
+		}
 	}
 
-	log.Printf("[DEBUG] Waiting for DNS Zone (%s) to update", d.Id())
-	stateConf := &resource.StateChangeConf{
-		Target:     []string{"ACTIVE"},
-		Pending:    []string{"PENDING"},
-		Refresh:    waitForDNSZone(dnsClient, d.Id()),
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
-		Delay:      5 * time.Second,
-		MinTimeout: 3 * time.Second,
+	if d.HasChange("router") && d.Get("type").(string) == "private" {
+		o, n := d.GetChange("router")
+		oldRouters := o.(*schema.Set)
+		newRouters := n.(*schema.Set)
+		if newRouters.Len() < 1 {
+			return fmt.Errorf("The argument (router) is required for OpenTelekomCloud DNS private zone")
+		}
+
+		// Associate new routers first, a private zone must keep at least one router
+		for _, opts := range resourceDNSZoneV2Routers(newRouters.Difference(oldRouters).List()) {
+			log.Printf("[DEBUG] Associating DNS Zone (%s) with router: %#v", d.Id(), opts)
+			_, err = zones.AssociateZone(dnsClient, d.Id(), opts).Extract()
+			if err != nil {
+				return fmt.Errorf("Error associating OpenTelekomCloud DNS Zone (%s) with router (%s): %s", d.Id(), opts.RouterID, err)
+			}
+			if err = resourceDNSZoneV2WaitForActive(dnsClient, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf(
+					"Error waiting for DNS Zone (%s) to become ACTIVE: %s",
+					d.Id(), err)
+			}
+		}
+
+		for _, opts := range resourceDNSZoneV2Routers(oldRouters.Difference(newRouters).List()) {
+			log.Printf("[DEBUG] Disassociating DNS Zone (%s) from router: %#v", d.Id(), opts)
+			_, err = zones.DisassociateZone(dnsClient, d.Id(), opts).Extract()
+			if err != nil {
+				return fmt.Errorf("Error disassociating OpenTelekomCloud DNS Zone (%s) from router (%s): %s", d.Id(), opts.RouterID, err)
+			}
+			if err = resourceDNSZoneV2WaitForActive(dnsClient, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf(
+					"Error waiting for DNS Zone (%s) to become ACTIVE: %s",
+					d.Id(), err)
+			}
+		}
 	}
 
-	_, err = stateConf.WaitForState()
+	if resourceTagsChanged(d, meta) {
+		tagManager := resourceDNSZoneV2TagManager(dnsClient, d.Get("type").(string))
+		if err := setResourceTags(d, meta, tagManager); err != nil {
+			return fmt.Errorf("Error updating tags of OpenTelekomCloud DNS Zone (%s): %s", d.Id(), err)
+		}
+	}
 
 	return resourceDNSZoneV2Read(d, meta)
 }
@@ -72,6 +72,43 @@ func TestAccDNSV2Zone_private(t *testing.T) {
 	})
 }
 
+func TestAccDNSV2Zone_privateRouters(t *testing.T) {
+	var zone zones.Zone
+	var zoneName = fmt.Sprintf("acpttest%s.com.", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDNS(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSV2ZoneDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDNSV2Zone_private(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2ZoneExists("opentelekomcloud_dns_zone_v2.zone_1", &zone),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_dns_zone_v2.zone_1", "router.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDNSV2Zone_privateRouters(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2ZoneExists("opentelekomcloud_dns_zone_v2.zone_1", &zone),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_dns_zone_v2.zone_1", "router.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDNSV2Zone_privateRoutersUpdate(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2ZoneExists("opentelekomcloud_dns_zone_v2.zone_1", &zone),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_dns_zone_v2.zone_1", "router.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 // PASS, but normally skip
 func TestAccDNSV2Zone_readTTL(t *testing.T) {
 	var zone zones.Zone
@@ -198,6 +235,52 @@ func testAccDNSV2Zone_private(zoneName string) string {
 	`, zoneName, OS_VPC_ID, OS_REGION_NAME)
 }
 
+func testAccDNSV2Zone_privateRouters(zoneName string) string {
+	return fmt.Sprintf(`
+		resource "opentelekomcloud_vpc_v1" "vpc_1" {
+			name = "vpc_dns_test"
+			cidr = "192.168.0.0/16"
+		}
+
+		resource "opentelekomcloud_dns_zone_v2" "zone_1" {
+			name = "%s"
+			email = "email1@example.com"
+			description = "a zone"
+			ttl = 3000
+			type = "private"
+			router = {
+				router_id = "%s"
+				router_region = "%s"
+			}
+			router = {
+				router_id = "${opentelekomcloud_vpc_v1.vpc_1.id}"
+				router_region = "%s"
+			}
+		}
+	`, zoneName, OS_VPC_ID, OS_REGION_NAME, OS_REGION_NAME)
+}
+
+func testAccDNSV2Zone_privateRoutersUpdate(zoneName string) string {
+	return fmt.Sprintf(`
+		resource "opentelekomcloud_vpc_v1" "vpc_1" {
+			name = "vpc_dns_test"
+			cidr = "192.168.0.0/16"
+		}
+
+		resource "opentelekomcloud_dns_zone_v2" "zone_1" {
+			name = "%s"
+			email = "email1@example.com"
+			description = "a zone"
+			ttl = 3000
+			type = "private"
+			router = {
+				router_id = "${opentelekomcloud_vpc_v1.vpc_1.id}"
+				router_region = "%s"
+			}
+		}
+	`, zoneName, OS_REGION_NAME)
+}
+
 func testAccDNSV2Zone_update(zoneName string) string {
 	return fmt.Sprintf(`
 		resource "opentelekomcloud_dns_zone_v2" "zone_1" {
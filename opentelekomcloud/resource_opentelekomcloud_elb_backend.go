@@ -3,9 +3,9 @@ package opentelekomcloud
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
-	// "github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 
 	"github.com/huaweicloud/golangsdk"
@@ -16,14 +16,26 @@ func resourceBackend() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBackendCreate,
 		Read:   resourceBackendRead,
+		Update: resourceBackendUpdate,
 		Delete: resourceBackendDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceBackendImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
 			"listener_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
@@ -33,13 +45,11 @@ func resourceBackend() *schema.Resource {
 			"server_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 
 			"address": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 		},
 	}
@@ -52,6 +62,18 @@ func resourceBackendCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
 	}
 
+	id, err := addBackendMember(d, client, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+	d.SetId(id)
+
+	return resourceBackendRead(d, meta)
+}
+
+// addBackendMember adds the backend configured in d to its listener and
+// returns the ID of the new member once the job has finished.
+func addBackendMember(d *schema.ResourceData, client *golangsdk.ServiceClient, timeout time.Duration) (string, error) {
 	addOpts := backendmember.AddOpts{
 		ServerId: d.Get("server_id").(string),
 		Address:  d.Get("address").(string),
@@ -61,15 +83,18 @@ func resourceBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	listener_id := d.Get("listener_id").(string)
 	job, err := backendmember.Add(client, listener_id, addOpts).ExtractJobResponse()
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	log.Printf("Waiting for backend to become active")
-	if err := golangsdk.WaitForJobSuccess(client, job.URI, int(d.Timeout(schema.TimeoutCreate)/time.Second)); err != nil {
-		return err
+	if err := golangsdk.WaitForJobSuccess(client, job.URI, int(timeout/time.Second)); err != nil {
+		return "", err
 	}
 
 	entity, err := golangsdk.GetJobEntity(client, job.URI, "members")
+	if err != nil {
+		return "", err
+	}
 
 	if members, ok := entity.([]interface{}); ok {
 		if len(members) > 0 {
@@ -77,14 +102,26 @@ func resourceBackendCreate(d *schema.ResourceData, meta interface{}) error {
 			if member, ok := vmember.(map[string]interface{}); ok {
 				if vid, ok := member["id"]; ok {
 					if id, ok := vid.(string); ok {
-						d.SetId(id)
-						return resourceBackendRead(d, meta)
+						return id, nil
 					}
 				}
 			}
 		}
 	}
-	return fmt.Errorf("Unexpected conversion error in resourceBackendCreate.")
+	return "", fmt.Errorf("Unexpected conversion error in addBackendMember.")
+}
+
+// removeBackendMember removes the backend member with the given ID from
+// its listener and waits for the job to finish.
+func removeBackendMember(client *golangsdk.ServiceClient, listener_id, id string, timeout time.Duration) error {
+	job, err := backendmember.Remove(client, listener_id, id).ExtractJobResponse()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Waiting for backend member %s to delete", id)
+
+	return golangsdk.WaitForJobSuccess(client, job.URI, int(timeout/time.Second))
 }
 
 func resourceBackendRead(d *schema.ResourceData, meta interface{}) error {
@@ -101,6 +138,13 @@ func resourceBackendRead(d *schema.ResourceData, meta interface{}) error {
 		return CheckDeleted(d, err, "backend member")
 	}
 
+	// The API answers with an empty list once the member has been removed
+	if len(backend) == 0 {
+		log.Printf("[WARN] Backend member %s not found on listener %s, removing from state", id, listener_id)
+		d.SetId("")
+		return nil
+	}
+
 	log.Printf("[DEBUG] Retrieved backend member %s: %#v", id, backend)
 
 	b := backend[0]
@@ -112,6 +156,34 @@ func resourceBackendRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.loadELBClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	// Backend members cannot be modified in place, so the new member is
+	// added before the old one is removed to keep the listener serving.
+	if d.HasChange("server_id") || d.HasChange("address") {
+		oldID := d.Id()
+		listener_id := d.Get("listener_id").(string)
+
+		id, err := addBackendMember(d, client, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+		d.SetId(id)
+
+		log.Printf("[DEBUG] Replacing backend member %s with %s", oldID, id)
+		if err := removeBackendMember(client, listener_id, oldID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceBackendRead(d, meta)
+}
+
 func resourceBackendDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	client, err := config.loadELBClient(GetRegion(d, config))
@@ -122,17 +194,22 @@ func resourceBackendDelete(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Deleting backend member %s", d.Id())
 	listener_id := d.Get("listener_id").(string)
 	id := d.Id()
-	job, err := backendmember.Remove(client, listener_id, id).ExtractJobResponse()
-	if err != nil {
+	if err := removeBackendMember(client, listener_id, id, d.Timeout(schema.TimeoutDelete)); err != nil {
 		return err
 	}
 
-	log.Printf("Waiting for backend member %s to delete", id)
+	log.Printf("Successfully deleted backend member %s", id)
+	return nil
+}
 
-	if err := golangsdk.WaitForJobSuccess(client, job.URI, int(d.Timeout(schema.TimeoutDelete)/time.Second)); err != nil {
-		return err
+func resourceBackendImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Invalid format specified for backend member. Format must be <listener_id>/<member_id>")
 	}
 
-	log.Printf("Successfully deleted backend member %s", id)
-	return nil
+	d.Set("listener_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
 }
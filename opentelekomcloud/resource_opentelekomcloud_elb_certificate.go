@@ -0,0 +1,181 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/elbaas/certificates"
+)
+
+func resourceECertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceECertificateCreate,
+		Read:   resourceECertificateRead,
+		Update: resourceECertificateUpdate,
+		Delete: resourceECertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"certificate": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressEquivalentPEMDiffs,
+			},
+
+			"private_key": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressEquivalentPEMDiffs,
+			},
+
+			"create_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"update_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceECertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.loadELBClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	createOpts := certificates.CreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Domain:      d.Get("domain").(string),
+		Certificate: d.Get("certificate").(string),
+		PrivateKey:  d.Get("private_key").(string),
+	}
+
+	// Do not log the private key
+	log.Printf("[DEBUG] Create Options: name %q, domain %q", createOpts.Name, createOpts.Domain)
+
+	c, err := certificates.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating certificate: %s", err)
+	}
+	d.SetId(c.ID)
+
+	log.Printf("[DEBUG] Successfully created certificate %s", c.ID)
+
+	return resourceECertificateRead(d, meta)
+}
+
+func resourceECertificateRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.loadELBClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	c, err := certificates.Get(client, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "certificate")
+	}
+
+	log.Printf("[DEBUG] Retrieved certificate %s: %s", d.Id(), c.Name)
+
+	d.Set("name", c.Name)
+	d.Set("description", c.Description)
+	d.Set("domain", c.Domain)
+	d.Set("certificate", c.Certificate)
+	d.Set("private_key", c.PrivateKey)
+	d.Set("create_time", c.CreateTime)
+	d.Set("update_time", c.UpdateTime)
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceECertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.loadELBClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	var updateOpts certificates.UpdateOpts
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	log.Printf("[DEBUG] Updating certificate %s with options: %#v", d.Id(), updateOpts)
+
+	_, err = certificates.Update(client, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating certificate %s: %s", d.Id(), err)
+	}
+
+	return resourceECertificateRead(d, meta)
+}
+
+func resourceECertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.loadELBClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	id := d.Id()
+	log.Printf("[DEBUG] Deleting certificate %s", id)
+
+	if err := certificates.Delete(client, id).ExtractErr(); err != nil {
+		return CheckDeleted(d, err, "certificate")
+	}
+
+	log.Printf("Successfully deleted certificate %s", id)
+	return nil
+}
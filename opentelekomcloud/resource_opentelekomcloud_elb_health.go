@@ -16,6 +16,9 @@ func resourceHealth() *schema.Resource {
 		Read:   resourceHealthRead,
 		Update: resourceHealthUpdate,
 		Delete: resourceHealthDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -139,7 +142,7 @@ func resourceHealthRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("listener_id", health.ListenerID)
 	d.Set("healthcheck_protocol", health.HealthcheckProtocol)
 	d.Set("healthcheck_uri", health.HealthcheckUri)
-	d.Set("healtcheck_connect_port", health.HealthcheckConnectPort)
+	d.Set("healthcheck_connect_port", health.HealthcheckConnectPort)
 	d.Set("healthy_threshold", health.HealthyThreshold)
 	d.Set("unhealthy_threshold", health.UnhealthyThreshold)
 	d.Set("healthcheck_timeout", health.HealthcheckTimeout)
@@ -165,7 +168,7 @@ func resourceHealthUpdate(d *schema.ResourceData, meta interface{}) error {
 		updateOpts.HealthcheckUri = d.Get("healthcheck_uri").(string)
 	}
 	if d.HasChange("healthcheck_connect_port") {
-		updateOpts.HealthyThreshold = d.Get("healthcheck_connect_port").(int)
+		updateOpts.HealthcheckConnectPort = d.Get("healthcheck_connect_port").(int)
 	}
 	if d.HasChange("healthy_threshold") {
 		updateOpts.HealthyThreshold = d.Get("healthy_threshold").(int)
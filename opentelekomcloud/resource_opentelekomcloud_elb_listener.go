@@ -21,6 +21,9 @@ func resourceEListener() *schema.Resource {
 		Read:   resourceEListenerRead,
 		Update: resourceEListenerUpdate,
 		Delete: resourceEListenerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -237,13 +240,12 @@ func resourceEListenerRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("backend_port", listener.BackendProtocolPort)
 	d.Set("backend_protocol", listener.BackendProtocol)
-	d.Set("sticky_session_type", listener.StickySessionType)
+	d.Set("session_sticky_type", listener.StickySessionType)
 	d.Set("description", listener.Description)
-	d.Set("load_balancer_id", listener.LoadbalancerID)
+	d.Set("loadbalancer_id", listener.LoadbalancerID)
 	d.Set("protocol", listener.Protocol)
 	d.Set("protocol_port", listener.ProtocolPort)
 	d.Set("cookie_timeout", listener.CookieTimeout)
-	d.Set("admin_state_up", listener.AdminStateUp)
 	d.Set("session_sticky", listener.SessionSticky)
 	d.Set("lb_algorithm", listener.Algorithm)
 	d.Set("name", listener.Name)
@@ -278,8 +280,8 @@ func resourceEListenerUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("protocol_port") {
 		updateOpts.ProtocolPort = d.Get("protocol_port").(int)
 	}
-	if d.HasChange("backend_protocol_port") {
-		updateOpts.ProtocolPort = d.Get("backend_protocol_port").(int)
+	if d.HasChange("backend_port") {
+		updateOpts.BackendProtocolPort = d.Get("backend_port").(int)
 	}
 	if d.HasChange("lb_algorithm") {
 		updateOpts.Algorithm = d.Get("lb_algorithm").(string)
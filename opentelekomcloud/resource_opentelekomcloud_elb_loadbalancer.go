@@ -17,6 +17,9 @@ func resourceELoadBalancer() *schema.Resource {
 		Read:   resourceELoadBalancerRead,
 		Update: resourceELoadBalancerUpdate,
 		Delete: resourceELoadBalancerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
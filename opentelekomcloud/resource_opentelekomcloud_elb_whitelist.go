@@ -0,0 +1,150 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/elbaas/whitelists"
+)
+
+func resourceEWhitelist() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEWhitelistCreate,
+		Read:   resourceEWhitelistRead,
+		Update: resourceEWhitelistUpdate,
+		Delete: resourceEWhitelistDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"listener_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"enable_whitelist": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"whitelist": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceEWhitelistCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.loadELBClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	enableWhitelist := d.Get("enable_whitelist").(bool)
+	createOpts := whitelists.CreateOpts{
+		ListenerID:      d.Get("listener_id").(string),
+		EnableWhitelist: &enableWhitelist,
+		Whitelist:       d.Get("whitelist").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	wl, err := whitelists.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating whitelist: %s", err)
+	}
+	d.SetId(wl.ID)
+
+	log.Printf("[DEBUG] Successfully created whitelist %s", wl.ID)
+
+	return resourceEWhitelistRead(d, meta)
+}
+
+func resourceEWhitelistRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.loadELBClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	wl, err := whitelists.Get(client, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "whitelist")
+	}
+
+	log.Printf("[DEBUG] Retrieved whitelist %s: %#v", d.Id(), wl)
+
+	d.Set("listener_id", wl.ListenerID)
+	d.Set("enable_whitelist", wl.EnableWhitelist)
+	d.Set("whitelist", wl.Whitelist)
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceEWhitelistUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.loadELBClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	var updateOpts whitelists.UpdateOpts
+	if d.HasChange("enable_whitelist") {
+		enableWhitelist := d.Get("enable_whitelist").(bool)
+		updateOpts.EnableWhitelist = &enableWhitelist
+	}
+	if d.HasChange("whitelist") {
+		whitelist := d.Get("whitelist").(string)
+		updateOpts.Whitelist = &whitelist
+	}
+
+	log.Printf("[DEBUG] Updating whitelist %s with options: %#v", d.Id(), updateOpts)
+
+	_, err = whitelists.Update(client, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating whitelist %s: %s", d.Id(), err)
+	}
+
+	return resourceEWhitelistRead(d, meta)
+}
+
+func resourceEWhitelistDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.loadELBClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	id := d.Id()
+	log.Printf("[DEBUG] Deleting whitelist %s", id)
+
+	if err := whitelists.Delete(client, id).ExtractErr(); err != nil {
+		return CheckDeleted(d, err, "whitelist")
+	}
+
+	log.Printf("Successfully deleted whitelist %s", id)
+	return nil
+}
@@ -0,0 +1,140 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/elbaas/whitelists"
+)
+
+func TestAccELBWhitelist_basic(t *testing.T) {
+	var wl whitelists.Whitelist
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckELBWhitelistDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccELBWhitelistConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckELBWhitelistExists("opentelekomcloud_elb_whitelist.whitelist_1", &wl),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_elb_whitelist.whitelist_1", "whitelist", "192.168.11.1,192.168.0.1/24"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccELBWhitelistConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_elb_whitelist.whitelist_1", "enable_whitelist", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckELBWhitelistDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	client, err := config.loadELBClient(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_elb_whitelist" {
+			continue
+		}
+
+		_, err := whitelists.Get(client, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Whitelist still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckELBWhitelistExists(n string, wl *whitelists.Whitelist) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		client, err := config.loadELBClient(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+		}
+
+		found, err := whitelists.Get(client, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Whitelist not found")
+		}
+
+		*wl = *found
+
+		return nil
+	}
+}
+
+var testAccELBWhitelistConfig_basic = fmt.Sprintf(`
+resource "opentelekomcloud_elb_loadbalancer" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vpc_id = "%s"
+  type = "External"
+  bandwidth = 5
+}
+
+resource "opentelekomcloud_elb_listener" "listener_1" {
+  name = "listener_1"
+  protocol = "TCP"
+  protocol_port = 8080
+  backend_protocol = "TCP"
+  backend_port = 8080
+  lb_algorithm = "roundrobin"
+  loadbalancer_id = "${opentelekomcloud_elb_loadbalancer.loadbalancer_1.id}"
+}
+
+resource "opentelekomcloud_elb_whitelist" "whitelist_1" {
+  enable_whitelist = true
+  whitelist = "192.168.11.1,192.168.0.1/24"
+  listener_id = "${opentelekomcloud_elb_listener.listener_1.id}"
+}
+`, OS_VPC_ID)
+
+var testAccELBWhitelistConfig_update = fmt.Sprintf(`
+resource "opentelekomcloud_elb_loadbalancer" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vpc_id = "%s"
+  type = "External"
+  bandwidth = 5
+}
+
+resource "opentelekomcloud_elb_listener" "listener_1" {
+  name = "listener_1"
+  protocol = "TCP"
+  protocol_port = 8080
+  backend_protocol = "TCP"
+  backend_port = 8080
+  lb_algorithm = "roundrobin"
+  loadbalancer_id = "${opentelekomcloud_elb_loadbalancer.loadbalancer_1.id}"
+}
+
+resource "opentelekomcloud_elb_whitelist" "whitelist_1" {
+  enable_whitelist = false
+  whitelist = "192.168.11.1,192.168.0.1/24"
+  listener_id = "${opentelekomcloud_elb_listener.listener_1.id}"
+}
+`, OS_VPC_ID)
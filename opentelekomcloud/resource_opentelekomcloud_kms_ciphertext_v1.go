@@ -0,0 +1,88 @@
+package opentelekomcloud
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk/openstack/kms/v1/keys"
+)
+
+func resourceKmsCiphertextV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKmsCiphertextV1Create,
+		Read:   resourceKmsCiphertextV1Read,
+		Delete: resourceKmsCiphertextV1Delete,
+
+		Schema: map[string]*schema.Schema{
+			"key_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"plain_text": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+				// just stash the hash for state & diff comparisons
+				StateFunc: func(v interface{}) string {
+					switch v.(type) {
+					case string:
+						hash := sha1.Sum([]byte(v.(string)))
+						return hex.EncodeToString(hash[:])
+					default:
+						return ""
+					}
+				},
+			},
+			"encryption_context": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"cipher_text": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKmsCiphertextV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmsKeyV1Client, err := config.kmsKeyV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud kms key client: %s", err)
+	}
+
+	encryptOpts := &keys.EncryptDataOpts{
+		KeyID:             d.Get("key_id").(string),
+		EncryptionContext: d.Get("encryption_context").(string),
+		PlainText:         d.Get("plain_text").(string),
+	}
+
+	log.Printf("[DEBUG] KMS encrypt data with key: %s", encryptOpts.KeyID)
+	v, err := keys.EncryptData(kmsKeyV1Client, encryptOpts).ExtractEncryptedData()
+	if err != nil {
+		return fmt.Errorf("Error encrypting data with OpenTelekomCloud key: %s", err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("cipher_text", v.CipherText)
+
+	return resourceKmsCiphertextV1Read(d, meta)
+}
+
+// The ciphertext never changes once created, so there is nothing to refresh.
+func resourceKmsCiphertextV1Read(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceKmsCiphertextV1Delete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,45 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccKmsCiphertextV1_basic(t *testing.T) {
+	var keyAlias = fmt.Sprintf("kms_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKmsV1KeyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKmsV1Ciphertext_basic(keyAlias),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"opentelekomcloud_kms_ciphertext_v1.ciphertext_1", "cipher_text"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_kms_ciphertext_v1.ciphertext_1", "plain_text",
+						"7e0d23a427f30aea17b4652a6bda1221fbd5f07f"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKmsV1Ciphertext_basic(keyAlias string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "key_1" {
+  key_alias    = "%s"
+  pending_days = "7"
+}
+
+resource "opentelekomcloud_kms_ciphertext_v1" "ciphertext_1" {
+  key_id     = "${opentelekomcloud_kms_key_v1.key_1.id}"
+  plain_text = "Super secret data"
+}
+`, keyAlias)
+}
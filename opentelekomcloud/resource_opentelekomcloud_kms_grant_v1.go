@@ -0,0 +1,203 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/kms/v1/grants"
+)
+
+func resourceKmsGrantV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKmsGrantV1Create,
+		Read:   resourceKmsGrantV1Read,
+		Delete: resourceKmsGrantV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceKmsGrantV1Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"grantee_principal": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"grantee_principal_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "user",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"user", "domain"})
+				},
+			},
+			"operations": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+						return ValidateStringList(v, k, []string{
+							"create-datakey", "create-datakey-without-plaintext",
+							"encrypt-datakey", "decrypt-datakey", "describe-key",
+							"create-grant", "retire-grant", "encrypt-data", "decrypt-data",
+						})
+					},
+				},
+				Set: schema.HashString,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"retiring_principal": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"issuing_principal": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKmsGrantV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmsKeyV1Client, err := config.kmsKeyV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud kms key client: %s", err)
+	}
+
+	var operations []string
+	for _, op := range d.Get("operations").(*schema.Set).List() {
+		operations = append(operations, op.(string))
+	}
+
+	createOpts := &grants.CreateOpts{
+		KeyID:                d.Get("key_id").(string),
+		GranteePrincipal:     d.Get("grantee_principal").(string),
+		GranteePrincipalType: d.Get("grantee_principal_type").(string),
+		Operations:           operations,
+		Name:                 d.Get("name").(string),
+		RetiringPrincipal:    d.Get("retiring_principal").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	v, err := grants.Create(kmsKeyV1Client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud kms grant: %s", err)
+	}
+	log.Printf("[INFO] Grant ID: %s", v.GrantID)
+
+	d.SetId(v.GrantID)
+
+	return resourceKmsGrantV1Read(d, meta)
+}
+
+func resourceKmsGrantV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmsKeyV1Client, err := config.kmsKeyV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud kms key client: %s", err)
+	}
+
+	grant, err := getKmsGrantV1(kmsKeyV1Client, d.Get("key_id").(string), d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving OpenTelekomCloud kms grant: %s", err)
+	}
+	if grant == nil {
+		log.Printf("[WARN] Removing kms grant %s because it's already gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[DEBUG] Kms grant %s: %+v", d.Id(), grant)
+
+	d.Set("key_id", grant.KeyID)
+	d.Set("grantee_principal", grant.GranteePrincipal)
+	d.Set("operations", grant.Operations)
+	d.Set("name", grant.Name)
+	d.Set("retiring_principal", grant.RetiringPrincipal)
+	d.Set("issuing_principal", grant.IssuingPrincipal)
+	d.Set("creation_date", grant.CreationDate)
+
+	return nil
+}
+
+func resourceKmsGrantV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmsKeyV1Client, err := config.kmsKeyV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud kms key client: %s", err)
+	}
+
+	revokeOpts := &grants.RevokeOpts{
+		KeyID:   d.Get("key_id").(string),
+		GrantID: d.Id(),
+	}
+	err = grants.Revoke(kmsKeyV1Client, revokeOpts).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "kms grant")
+	}
+
+	log.Printf("[DEBUG] Kms grant %s revoked", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceKmsGrantV1Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		err := fmt.Errorf("Invalid format specified for KMS Grant. Format must be <key id>/<grant id>")
+		return nil, err
+	}
+
+	keyID := parts[0]
+	grantID := parts[1]
+
+	d.SetId(grantID)
+	d.Set("key_id", keyID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getKmsGrantV1 pages through the grants of a key and returns the one with
+// the given ID, or nil if the key has no such grant.
+func getKmsGrantV1(client *golangsdk.ServiceClient, keyID, grantID string) (*grants.Grant, error) {
+	listOpts := &grants.ListOpts{
+		KeyID: keyID,
+	}
+	for {
+		page, err := grants.List(client, listOpts).ExtractListGrant()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, grant := range page.Grants {
+			if grant.GrantID == grantID {
+				return &grant, nil
+			}
+		}
+
+		if page.Truncated != "true" || page.NextMarker == "" {
+			return nil, nil
+		}
+		listOpts.Marker = page.NextMarker
+	}
+}
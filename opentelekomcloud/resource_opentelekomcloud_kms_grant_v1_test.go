@@ -0,0 +1,102 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/huaweicloud/golangsdk/openstack/kms/v1/grants"
+)
+
+func TestAccKmsGrantV1_basic(t *testing.T) {
+	var grant grants.Grant
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKmsV1GrantDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKmsV1Grant_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsV1GrantExists("opentelekomcloud_kms_grant_v1.grant_1", &grant),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_kms_grant_v1.grant_1", "name", "tf-acc-test-grant-"+rName),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_kms_grant_v1.grant_1", "operations.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKmsV1GrantDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	kmsClient, err := config.kmsKeyV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud kms client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_kms_grant_v1" {
+			continue
+		}
+		grant, err := getKmsGrantV1(kmsClient, rs.Primary.Attributes["key_id"], rs.Primary.ID)
+		if err != nil {
+			// The key itself may already be pending deletion
+			continue
+		}
+		if grant != nil {
+			return fmt.Errorf("grant still exists")
+		}
+	}
+	return nil
+}
+
+func testAccCheckKmsV1GrantExists(n string, grant *grants.Grant) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		kmsClient, err := config.kmsKeyV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud kms client: %s", err)
+		}
+		found, err := getKmsGrantV1(kmsClient, rs.Primary.Attributes["key_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if found == nil {
+			return fmt.Errorf("grant not found")
+		}
+
+		*grant = *found
+		return nil
+	}
+}
+
+func testAccKmsV1Grant_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "key_1" {
+    key_alias    = "tf-acc-test-kms-key-%s"
+    pending_days = "7"
+}
+
+resource "opentelekomcloud_kms_grant_v1" "grant_1" {
+    key_id                 = "${opentelekomcloud_kms_key_v1.key_1.id}"
+    name                   = "tf-acc-test-grant-%s"
+    grantee_principal      = "${opentelekomcloud_kms_key_v1.key_1.domain_id}"
+    grantee_principal_type = "domain"
+    operations             = ["encrypt-data", "decrypt-data"]
+}`, rName, rName)
+}
@@ -1,6 +1,11 @@
 package opentelekomcloud
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
 	"fmt"
 	"log"
 	"time"
@@ -16,6 +21,12 @@ const (
 	EnabledState          = "2"
 	DisabledState         = "3"
 	PendingDeletionState  = "4"
+	PendingImportState    = "5"
+)
+
+const (
+	KmsOrigin      = "kms"
+	ExternalOrigin = "external"
 )
 
 func resourceKmsKeyV1() *schema.Resource {
@@ -70,12 +81,43 @@ func resourceKmsKeyV1() *schema.Resource {
 			},
 			"origin": &schema.Schema{
 				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{KmsOrigin, ExternalOrigin})
+				},
 			},
 			"pending_days": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"rotation_enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"rotation_interval": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateIntRange(v, k, 30, 365)
+				},
+			},
+			"key_material": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"key_material_expiration_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -87,10 +129,21 @@ func resourceKmsKeyV1Create(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenTelekomCloud kms key client: %s", err)
 	}
 
+	origin := d.Get("origin").(string)
+	keyMaterial := d.Get("key_material").(string)
+	if origin != ExternalOrigin {
+		if keyMaterial != "" {
+			return fmt.Errorf("key_material can only be set if origin is '%s'", ExternalOrigin)
+		}
+	} else if d.Get("rotation_enabled").(bool) {
+		return fmt.Errorf("Rotation is not supported for keys with origin '%s'", ExternalOrigin)
+	}
+
 	createOpts := &keys.CreateOpts{
 		KeyAlias:       d.Get("key_alias").(string),
 		KeyDescription: d.Get("key_description").(string),
 		Realm:          d.Get("realm").(string),
+		Origin:         origin,
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -100,23 +153,48 @@ func resourceKmsKeyV1Create(d *schema.ResourceData, meta interface{}) error {
 	}
 	log.Printf("[INFO] Key ID: %s", v.KeyID)
 
-	// Wait for the key to become enabled.
-	log.Printf("[DEBUG] Waiting for key (%s) to become enabled", v.KeyID)
+	// Store the key ID now
+	d.SetId(v.KeyID)
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{WaitingForEnableState, DisabledState},
-		Target:     []string{EnabledState},
-		Refresh:    keyV1StateRefreshFunc(kmsKeyV1Client, v.KeyID),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
+	if len(resourceTags(d, meta)) > 0 {
+		tagManager := newProjectTagManager(kmsKeyV1Client, "kms")
+		if err := setResourceTags(d, meta, tagManager); err != nil {
+			return fmt.Errorf("Error setting tags of OpenTelekomCloud key %s: %s", v.KeyID, err)
+		}
 	}
 
-	_, err = stateConf.WaitForState()
+	// Keys with external origin wait for their key material
+	if origin == ExternalOrigin {
+		log.Printf("[DEBUG] Waiting for key (%s) to become pending import", v.KeyID)
+		err = waitForKmsKeyV1State(kmsKeyV1Client, v.KeyID,
+			[]string{WaitingForEnableState}, PendingImportState, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+
+		if keyMaterial == "" {
+			return resourceKmsKeyV1Read(d, meta)
+		}
+
+		err = importKmsKeyV1Material(kmsKeyV1Client, v.KeyID, keyMaterial,
+			d.Get("key_material_expiration_time").(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	// Wait for the key to become enabled.
+	log.Printf("[DEBUG] Waiting for key (%s) to become enabled", v.KeyID)
+	err = waitForKmsKeyV1State(kmsKeyV1Client, v.KeyID,
+		[]string{WaitingForEnableState, DisabledState, PendingImportState}, EnabledState, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for key (%s) to become ready: %s",
-			v.KeyID, err)
+		return err
+	}
+
+	if d.Get("rotation_enabled").(bool) {
+		if err := enableKMSKeyV1Rotation(kmsKeyV1Client, d, v.KeyID); err != nil {
+			return fmt.Errorf("Error enabling key rotation: %s", err)
+		}
 	}
 
 	if !d.Get("is_enabled").(bool) {
@@ -130,9 +208,6 @@ func resourceKmsKeyV1Create(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	// Store the key ID now
-	d.SetId(v.KeyID)
-
 	return resourceKmsKeyV1Read(d, meta)
 }
 
@@ -163,11 +238,30 @@ func resourceKmsKeyV1Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("key_description", v.KeyDescription)
 	d.Set("creation_date", v.CreationDate)
 	d.Set("scheduled_deletion_date", v.ScheduledDeletionDate)
-	d.Set("is_enabled", v.KeyState == EnabledState)
 	d.Set("default_key_flag", v.DefaultKeyFlag)
 	d.Set("expiration_time", v.ExpirationTime)
 	d.Set("origin", v.Origin)
 
+	// A key waiting for its key material is neither enabled nor disabled
+	if v.KeyState == EnabledState || v.KeyState == DisabledState {
+		d.Set("is_enabled", v.KeyState == EnabledState)
+	}
+
+	// Rotation is only supported for keys with key material generated by KMS
+	if v.Origin != ExternalOrigin {
+		r, err := keys.GetKeyRotationStatus(kmsKeyV1Client, d.Id()).ExtractRotationStatus()
+		if err != nil {
+			return fmt.Errorf("Error fetching rotation status of key %s: %s", d.Id(), err)
+		}
+		d.Set("rotation_enabled", r.Enabled)
+		d.Set("rotation_interval", r.Interval)
+	}
+
+	tagManager := newProjectTagManager(kmsKeyV1Client, "kms")
+	if err := readResourceTags(d, meta, tagManager); err != nil {
+		return fmt.Errorf("Error fetching tags of key %s: %s", d.Id(), err)
+	}
+
 	return nil
 }
 
@@ -200,6 +294,24 @@ func resourceKmsKeyV1Update(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("rotation_enabled") || d.HasChange("rotation_interval") {
+		rotationOpts := &keys.RotationOpts{
+			KeyID:    d.Id(),
+			Interval: d.Get("rotation_interval").(int),
+		}
+
+		if !d.Get("rotation_enabled").(bool) {
+			err = keys.DisableKeyRotation(kmsKeyV1Client, rotationOpts).Err
+		} else if d.HasChange("rotation_enabled") {
+			err = enableKMSKeyV1Rotation(kmsKeyV1Client, d, d.Id())
+		} else {
+			err = keys.UpdateKeyRotationInterval(kmsKeyV1Client, rotationOpts).Err
+		}
+		if err != nil {
+			return fmt.Errorf("Error updating rotation of OpenTelekomCloud key: %s", err)
+		}
+	}
+
 	if d.HasChange("is_enabled") {
 		v, err := keys.Get(kmsKeyV1Client, d.Id()).ExtractKeyInfo()
 		if err != nil {
@@ -227,6 +339,13 @@ func resourceKmsKeyV1Update(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if resourceTagsChanged(d, meta) {
+		tagManager := newProjectTagManager(kmsKeyV1Client, "kms")
+		if err := setResourceTags(d, meta, tagManager); err != nil {
+			return fmt.Errorf("Error updating tags of OpenTelekomCloud key: %s", err)
+		}
+	}
+
 	return resourceKmsKeyV1Read(d, meta)
 }
 
@@ -281,3 +400,88 @@ func keyV1StateRefreshFunc(client *golangsdk.ServiceClient, keyID string) resour
 		return v, v.KeyState, nil
 	}
 }
+
+func waitForKmsKeyV1State(client *golangsdk.ServiceClient, keyID string, pending []string, target string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     []string{target},
+		Refresh:    keyV1StateRefreshFunc(client, keyID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for key (%s) to become ready: %s",
+			keyID, err)
+	}
+	return nil
+}
+
+// importKmsKeyV1Material wraps the base64 encoded key material with the
+// public key downloaded from KMS and imports it into the key.
+func importKmsKeyV1Material(client *golangsdk.ServiceClient, keyID, keyMaterial, expirationTime string) error {
+	material, err := base64.StdEncoding.DecodeString(keyMaterial)
+	if err != nil {
+		return fmt.Errorf("Error decoding key_material, it must be base64 encoded: %s", err)
+	}
+
+	paramsOpts := &keys.GetImportParamsOpts{
+		KeyID:             keyID,
+		WrappingAlgorithm: "RSAES_OAEP_SHA_256",
+	}
+	params, err := keys.GetImportParams(client, paramsOpts).ExtractImportParams()
+	if err != nil {
+		return fmt.Errorf("Error downloading wrapping key for key %s: %s", keyID, err)
+	}
+
+	der, err := base64.StdEncoding.DecodeString(params.PublicKey)
+	if err != nil {
+		return fmt.Errorf("Error decoding wrapping key for key %s: %s", keyID, err)
+	}
+	pub, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return fmt.Errorf("Error parsing wrapping key for key %s: %s", keyID, err)
+	}
+	rsaPub, ok := pub.(*rsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("Unexpected wrapping key type %T for key %s", pub, keyID)
+	}
+
+	wrapped, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, rsaPub, material, nil)
+	if err != nil {
+		return fmt.Errorf("Error wrapping key material for key %s: %s", keyID, err)
+	}
+
+	importOpts := &keys.ImportMaterialOpts{
+		KeyID:                keyID,
+		ImportToken:          params.ImportToken,
+		EncryptedKeyMaterial: base64.StdEncoding.EncodeToString(wrapped),
+		ExpirationTime:       expirationTime,
+	}
+	log.Printf("[DEBUG] Importing key material into key %s", keyID)
+	if err := keys.ImportKeyMaterial(client, importOpts).ExtractErr(); err != nil {
+		return fmt.Errorf("Error importing key material into key %s: %s", keyID, err)
+	}
+
+	return nil
+}
+
+// enableKMSKeyV1Rotation enables the rotation of a key. The interval is ignored
+// when enabling the rotation, so it is set afterwards.
+func enableKMSKeyV1Rotation(client *golangsdk.ServiceClient, d *schema.ResourceData, keyID string) error {
+	if err := keys.EnableKeyRotation(client, &keys.RotationOpts{KeyID: keyID}).Err; err != nil {
+		return err
+	}
+
+	if v, ok := d.GetOk("rotation_interval"); ok {
+		rotationOpts := &keys.RotationOpts{
+			KeyID:    keyID,
+			Interval: v.(int),
+		}
+		return keys.UpdateKeyRotationInterval(client, rotationOpts).Err
+	}
+	return nil
+}
@@ -42,6 +42,79 @@ func TestAccKmsKeyV1_basic(t *testing.T) {
 	})
 }
 
+func TestAccKmsKeyV1_rotation(t *testing.T) {
+	var key keys.Key
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKmsV1KeyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKmsV1Key_rotation(rName, true, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsV1KeyExists("opentelekomcloud_kms_key_v1.key_1", &key),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_kms_key_v1.key_1", "rotation_enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_kms_key_v1.key_1", "rotation_interval", "100"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccKmsV1Key_rotation(rName, true, 200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsV1KeyExists("opentelekomcloud_kms_key_v1.key_1", &key),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_kms_key_v1.key_1", "rotation_enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_kms_key_v1.key_1", "rotation_interval", "200"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccKmsV1Key_rotation(rName, false, 200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsV1KeyExists("opentelekomcloud_kms_key_v1.key_1", &key),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_kms_key_v1.key_1", "rotation_enabled", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccKmsV1Key_rotation(rName, true, 300),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsV1KeyExists("opentelekomcloud_kms_key_v1.key_1", &key),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_kms_key_v1.key_1", "rotation_enabled", "true"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_kms_key_v1.key_1", "rotation_interval", "300"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKmsKeyV1_external(t *testing.T) {
+	var key keys.Key
+	rName := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKmsV1KeyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKmsV1Key_external(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKmsV1KeyExists("opentelekomcloud_kms_key_v1.key_1", &key),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_kms_key_v1.key_1", "origin", "external"),
+					testAccCheckKmsKeyIsEnabled(&key, true),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckKmsV1KeyDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	kmsClient, err := config.kmsKeyV1Client(OS_REGION_NAME)
@@ -178,3 +251,23 @@ resource "opentelekomcloud_kms_key_v1" "bar" {
     is_enabled      = false
 }`, rName, rName)
 }
+
+func testAccKmsV1Key_rotation(rName string, enabled bool, interval int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "key_1" {
+    key_alias         = "tf-acc-test-kms-key-%s"
+    pending_days      = "7"
+    rotation_enabled  = %t
+    rotation_interval = %d
+}`, rName, enabled, interval)
+}
+
+func testAccKmsV1Key_external(rName string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "key_1" {
+    key_alias    = "tf-acc-test-kms-key-%s"
+    pending_days = "7"
+    origin       = "external"
+    key_material = "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8="
+}`, rName)
+}
@@ -0,0 +1,218 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/certificates"
+)
+
+func resourceCertificateV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCertificateV2Create,
+		Read:   resourceCertificateV2Read,
+		Update: resourceCertificateV2Update,
+		Delete: resourceCertificateV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "server",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"server", "client"})
+				},
+			},
+
+			"domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"private_key": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressEquivalentPEMDiffs,
+			},
+
+			"certificate": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentPEMDiffs,
+			},
+
+			"expire_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"create_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"update_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCertificateV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.hwNetworkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	certType := d.Get("type").(string)
+	privateKey := d.Get("private_key").(string)
+	if certType == "server" && privateKey == "" {
+		return fmt.Errorf("private_key needs to be set if using 'server' type.")
+	}
+	if certType == "client" && privateKey != "" {
+		return fmt.Errorf("private_key can not be set if using 'client' type.")
+	}
+
+	createOpts := certificates.CreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Type:        certificates.Type(certType),
+		Domain:      d.Get("domain").(string),
+		PrivateKey:  privateKey,
+		Certificate: d.Get("certificate").(string),
+	}
+
+	// Do not log the private key
+	log.Printf("[DEBUG] Create Options: name %q, type %q, domain %q", createOpts.Name, createOpts.Type, createOpts.Domain)
+
+	c, err := certificates.Create(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating certificate: %s", err)
+	}
+	d.SetId(c.ID)
+
+	log.Printf("[DEBUG] Successfully created certificate %s", c.ID)
+
+	return resourceCertificateV2Read(d, meta)
+}
+
+func resourceCertificateV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.hwNetworkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	c, err := certificates.Get(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "certificate")
+	}
+
+	log.Printf("[DEBUG] Retrieved certificate %s: %s", d.Id(), c.Name)
+
+	d.Set("name", c.Name)
+	d.Set("description", c.Description)
+	d.Set("domain", c.Domain)
+	d.Set("certificate", c.Certificate)
+	d.Set("private_key", c.PrivateKey)
+	d.Set("expire_time", c.ExpireTime)
+	d.Set("create_time", c.CreateTime)
+	d.Set("update_time", c.UpdateTime)
+
+	// Older API versions do not return the certificate type
+	if c.Type != "" {
+		d.Set("type", c.Type)
+	}
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceCertificateV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.hwNetworkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	var updateOpts certificates.UpdateOpts
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("domain") {
+		updateOpts.Domain = d.Get("domain").(string)
+	}
+	if d.HasChange("private_key") || d.HasChange("certificate") {
+		// The certificate and its private key are replaced together
+		updateOpts.PrivateKey = d.Get("private_key").(string)
+		updateOpts.Certificate = d.Get("certificate").(string)
+	}
+
+	log.Printf("[DEBUG] Updating certificate %s", d.Id())
+
+	_, err = certificates.Update(networkingClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating certificate %s: %s", d.Id(), err)
+	}
+
+	return resourceCertificateV2Read(d, meta)
+}
+
+func resourceCertificateV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.hwNetworkV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	id := d.Id()
+	log.Printf("[DEBUG] Deleting certificate %s", id)
+
+	if err := certificates.Delete(networkingClient, id).ExtractErr(); err != nil {
+		return CheckDeleted(d, err, "certificate")
+	}
+
+	log.Printf("Successfully deleted certificate %s", id)
+	return nil
+}
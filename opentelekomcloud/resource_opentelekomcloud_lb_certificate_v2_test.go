@@ -0,0 +1,113 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/certificates"
+)
+
+func TestAccLBV2Certificate_basic(t *testing.T) {
+	var c certificates.Certificate
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2CertificateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLBV2CertificateConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2CertificateExists("opentelekomcloud_lb_certificate_v2.certificate_1", &c),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lb_certificate_v2.certificate_1", "type", "server"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lb_certificate_v2.certificate_1", "domain", "www.example.com"),
+					resource.TestCheckResourceAttrSet(
+						"opentelekomcloud_lb_certificate_v2.certificate_1", "expire_time"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccLBV2CertificateConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lb_certificate_v2.certificate_1", "name", "certificate_1_updated"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lb_certificate_v2.certificate_1", "description", "updated certificate"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBV2CertificateDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.hwNetworkV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_lb_certificate_v2" {
+			continue
+		}
+
+		_, err := certificates.Get(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Certificate still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBV2CertificateExists(n string, c *certificates.Certificate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.hwNetworkV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+		}
+
+		found, err := certificates.Get(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Certificate not found")
+		}
+
+		*c = *found
+
+		return nil
+	}
+}
+
+var testAccLBV2CertificateConfig_basic = fmt.Sprintf(`
+resource "opentelekomcloud_lb_certificate_v2" "certificate_1" {
+  name = "certificate_1"
+  domain = "www.example.com"
+%s
+}
+`, testAccELBCertificate_pem)
+
+var testAccLBV2CertificateConfig_update = fmt.Sprintf(`
+resource "opentelekomcloud_lb_certificate_v2" "certificate_1" {
+  name = "certificate_1_updated"
+  description = "updated certificate"
+  domain = "www.example.com"
+%s
+}
+`, testAccELBCertificate_pem)
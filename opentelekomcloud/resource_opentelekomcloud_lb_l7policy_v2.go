@@ -0,0 +1,312 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/l7policies"
+)
+
+func resourceL7PolicyV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceL7PolicyV2Create,
+		Read:   resourceL7PolicyV2Read,
+		Update: resourceL7PolicyV2Update,
+		Delete: resourceL7PolicyV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"action": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"REDIRECT_TO_POOL", "REDIRECT_TO_LISTENER", "REJECT"})
+				},
+			},
+
+			"listener_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"position": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"redirect_pool_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"redirect_listener_id"},
+			},
+
+			"redirect_listener_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"redirect_pool_id"},
+			},
+
+			"admin_state_up": &schema.Schema{
+				Type:     schema.TypeBool,
+				Default:  true,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceL7PolicyV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	action := d.Get("action").(string)
+	redirectPoolID := d.Get("redirect_pool_id").(string)
+	redirectListenerID := d.Get("redirect_listener_id").(string)
+	if err := checkL7PolicyAction(action, redirectPoolID, redirectListenerID); err != nil {
+		return err
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	createOpts := l7policies.CreateOpts{
+		TenantID:           d.Get("tenant_id").(string),
+		Name:               d.Get("name").(string),
+		Description:        d.Get("description").(string),
+		Action:             l7policies.Action(action),
+		ListenerID:         d.Get("listener_id").(string),
+		Position:           int32(d.Get("position").(int)),
+		RedirectPoolID:     redirectPoolID,
+		RedirectListenerID: redirectListenerID,
+		AdminStateUp:       &adminStateUp,
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	// Wait for LoadBalancer to become active before continuing
+	timeout := d.Timeout(schema.TimeoutCreate)
+	listenerID := createOpts.ListenerID
+	err = waitForLBV2viaListener(networkingClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Attempting to create l7policy")
+	var l7Policy *l7policies.L7Policy
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		l7Policy, err = l7policies.Create(networkingClient, createOpts).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("Error creating l7policy: %s", err)
+	}
+
+	// Wait for LoadBalancer to become active before continuing
+	err = waitForLBV2viaListener(networkingClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(l7Policy.ID)
+
+	return resourceL7PolicyV2Read(d, meta)
+}
+
+func resourceL7PolicyV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	l7Policy, err := l7policies.Get(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "l7policy")
+	}
+
+	log.Printf("[DEBUG] Retrieved l7policy %s: %#v", d.Id(), l7Policy)
+
+	d.Set("action", l7Policy.Action)
+	d.Set("description", l7Policy.Description)
+	d.Set("tenant_id", l7Policy.TenantID)
+	d.Set("name", l7Policy.Name)
+	d.Set("position", int(l7Policy.Position))
+	d.Set("redirect_pool_id", l7Policy.RedirectPoolID)
+	d.Set("redirect_listener_id", l7Policy.RedirectListenerID)
+	d.Set("admin_state_up", l7Policy.AdminStateUp)
+	d.Set("listener_id", l7Policy.ListenerID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceL7PolicyV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	action := d.Get("action").(string)
+	redirectPoolID := d.Get("redirect_pool_id").(string)
+	redirectListenerID := d.Get("redirect_listener_id").(string)
+	if err := checkL7PolicyAction(action, redirectPoolID, redirectListenerID); err != nil {
+		return err
+	}
+
+	var updateOpts L7PolicyUpdateOpts
+	if d.HasChange("action") {
+		updateOpts.Action = l7policies.Action(action)
+	}
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("position") {
+		updateOpts.Position = int32(d.Get("position").(int))
+	}
+	if d.HasChange("redirect_pool_id") {
+		updateOpts.RedirectPoolID = redirectPoolID
+		updateOpts.ClearRedirectPoolID = redirectPoolID == ""
+	}
+	if d.HasChange("redirect_listener_id") {
+		updateOpts.RedirectListenerID = redirectListenerID
+		updateOpts.ClearRedirectListenerID = redirectListenerID == ""
+	}
+	if d.HasChange("admin_state_up") {
+		asu := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &asu
+	}
+
+	// Wait for LoadBalancer to become active before continuing
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	listenerID := d.Get("listener_id").(string)
+	err = waitForLBV2viaListener(networkingClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating l7policy %s with options: %#v", d.Id(), updateOpts)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		_, err = l7policies.Update(networkingClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("Unable to update l7policy %s: %s", d.Id(), err)
+	}
+
+	// Wait for LoadBalancer to become active before continuing
+	err = waitForLBV2viaListener(networkingClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	return resourceL7PolicyV2Read(d, meta)
+}
+
+func resourceL7PolicyV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	// Wait for LoadBalancer to become active before continuing
+	timeout := d.Timeout(schema.TimeoutDelete)
+	listenerID := d.Get("listener_id").(string)
+	err = waitForLBV2viaListener(networkingClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Attempting to delete l7policy %s", d.Id())
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err = l7policies.Delete(networkingClient, d.Id()).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return CheckDeleted(d, err, "l7policy")
+	}
+
+	// Wait for L7 Policy to delete
+	err = waitForLBV2L7Policy(networkingClient, d.Id(), "DELETED", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// checkL7PolicyAction makes sure the redirect target matches the action.
+func checkL7PolicyAction(action, redirectPoolID, redirectListenerID string) error {
+	switch action {
+	case "REDIRECT_TO_POOL":
+		if redirectPoolID == "" {
+			return fmt.Errorf("redirect_pool_id needs to be set if using 'REDIRECT_TO_POOL' action.")
+		}
+	case "REDIRECT_TO_LISTENER":
+		if redirectListenerID == "" {
+			return fmt.Errorf("redirect_listener_id needs to be set if using 'REDIRECT_TO_LISTENER' action.")
+		}
+	case "REJECT":
+		if redirectPoolID != "" || redirectListenerID != "" {
+			return fmt.Errorf("redirect_pool_id and redirect_listener_id can not be set if using 'REJECT' action.")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/l7policies"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccLBV2L7Policy_basic(t *testing.T) {
+	var l7Policy l7policies.L7Policy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2L7PolicyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLBV2L7PolicyConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2L7PolicyExists("opentelekomcloud_lb_l7policy_v2.l7policy_1", &l7Policy),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lb_l7policy_v2.l7policy_1", "name", "test"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lb_l7policy_v2.l7policy_1", "action", "REDIRECT_TO_POOL"),
+					resource.TestCheckResourceAttrPair(
+						"opentelekomcloud_lb_l7policy_v2.l7policy_1", "redirect_pool_id",
+						"opentelekomcloud_lb_pool_v2.pool_1", "id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckLBV2L7PolicyConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lb_l7policy_v2.l7policy_1", "name", "test_updated"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lb_l7policy_v2.l7policy_1", "action", "REDIRECT_TO_LISTENER"),
+					resource.TestCheckResourceAttrPair(
+						"opentelekomcloud_lb_l7policy_v2.l7policy_1", "redirect_listener_id",
+						"opentelekomcloud_lb_listener_v2.listener_2", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestL7PolicyUpdateOpts(t *testing.T) {
+	opts := L7PolicyUpdateOpts{
+		UpdateOpts: l7policies.UpdateOpts{
+			Action:             l7policies.ActionRedirectToListener,
+			RedirectListenerID: "listener",
+		},
+		ClearRedirectPoolID: true,
+	}
+
+	b, err := opts.ToL7PolicyUpdateMap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{
+		"l7policy": map[string]interface{}{
+			"action":               "REDIRECT_TO_LISTENER",
+			"redirect_listener_id": "listener",
+			"redirect_pool_id":     nil,
+		},
+	}
+	if !reflect.DeepEqual(b, expected) {
+		t.Fatalf("Expected request body %#v, got %#v", expected, b)
+	}
+}
+
+func testAccCheckLBV2L7PolicyDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_lb_l7policy_v2" {
+			continue
+		}
+
+		_, err := l7policies.Get(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("L7 Policy still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBV2L7PolicyExists(n string, l7Policy *l7policies.L7Policy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+		}
+
+		found, err := l7policies.Get(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("L7 Policy not found")
+		}
+
+		*l7Policy = *found
+
+		return nil
+	}
+}
+
+var testAccCheckLBV2L7PolicyConfig = fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "%s"
+}
+
+resource "opentelekomcloud_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "HTTP"
+  protocol_port = 8080
+  loadbalancer_id = "${opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "opentelekomcloud_lb_listener_v2" "listener_2" {
+  name = "listener_2"
+  protocol = "HTTP"
+  protocol_port = 8081
+  loadbalancer_id = "${opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "opentelekomcloud_lb_pool_v2" "pool_1" {
+  name = "pool_1"
+  protocol = "HTTP"
+  lb_method = "ROUND_ROBIN"
+  loadbalancer_id = "${opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+`, OS_SUBNET_ID)
+
+var testAccCheckLBV2L7PolicyConfig_basic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_l7policy_v2" "l7policy_1" {
+  name = "test"
+  action = "REDIRECT_TO_POOL"
+  description = "test description"
+  position = 1
+  listener_id = "${opentelekomcloud_lb_listener_v2.listener_1.id}"
+  redirect_pool_id = "${opentelekomcloud_lb_pool_v2.pool_1.id}"
+}
+`, testAccCheckLBV2L7PolicyConfig)
+
+var testAccCheckLBV2L7PolicyConfig_update = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_l7policy_v2" "l7policy_1" {
+  name = "test_updated"
+  action = "REDIRECT_TO_LISTENER"
+  description = "test description"
+  position = 1
+  listener_id = "${opentelekomcloud_lb_listener_v2.listener_1.id}"
+  redirect_listener_id = "${opentelekomcloud_lb_listener_v2.listener_2.id}"
+}
+`, testAccCheckLBV2L7PolicyConfig)
@@ -0,0 +1,311 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/l7policies"
+)
+
+func resourceL7RuleV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceL7RuleV2Create,
+		Read:   resourceL7RuleV2Read,
+		Update: resourceL7RuleV2Update,
+		Delete: resourceL7RuleV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceL7RuleV2Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"HOST_NAME", "PATH"})
+				},
+			},
+
+			"compare_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"STARTS_WITH", "EQUAL_TO", "REGEX"})
+				},
+			},
+
+			"l7policy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"listener_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"value": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if len(v.(string)) == 0 {
+						errors = append(errors, fmt.Errorf("'value' field should not be empty"))
+					}
+					return
+				},
+			},
+
+			"key": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"invert": &schema.Schema{
+				Type:     schema.TypeBool,
+				Default:  false,
+				Optional: true,
+			},
+
+			"admin_state_up": &schema.Schema{
+				Type:     schema.TypeBool,
+				Default:  true,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceL7RuleV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	l7policyID := d.Get("l7policy_id").(string)
+	adminStateUp := d.Get("admin_state_up").(bool)
+	createOpts := l7policies.CreateRuleOpts{
+		TenantID:     d.Get("tenant_id").(string),
+		RuleType:     l7policies.RuleType(d.Get("type").(string)),
+		CompareType:  l7policies.CompareType(d.Get("compare_type").(string)),
+		Value:        d.Get("value").(string),
+		Key:          d.Get("key").(string),
+		Invert:       d.Get("invert").(bool),
+		AdminStateUp: &adminStateUp,
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	// Get the L7 policy to find the listener and LoadBalancer
+	l7Policy, err := l7policies.Get(networkingClient, l7policyID).Extract()
+	if err != nil {
+		return fmt.Errorf("Unable to get l7policy %s: %s", l7policyID, err)
+	}
+	listenerID := l7Policy.ListenerID
+
+	// Wait for LoadBalancer to become active before continuing
+	timeout := d.Timeout(schema.TimeoutCreate)
+	err = waitForLBV2viaListener(networkingClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Attempting to create l7rule")
+	var l7Rule *l7policies.Rule
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		l7Rule, err = l7policies.CreateRule(networkingClient, l7policyID, createOpts).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("Error creating l7rule: %s", err)
+	}
+
+	// Wait for LoadBalancer to become active before continuing
+	err = waitForLBV2viaListener(networkingClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(l7Rule.ID)
+	d.Set("listener_id", listenerID)
+
+	return resourceL7RuleV2Read(d, meta)
+}
+
+func resourceL7RuleV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	l7policyID := d.Get("l7policy_id").(string)
+
+	l7Rule, err := l7policies.GetRule(networkingClient, l7policyID, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "l7rule")
+	}
+
+	log.Printf("[DEBUG] Retrieved l7rule %s: %#v", d.Id(), l7Rule)
+
+	// The listener is needed to track the LoadBalancer state, so it is
+	// refreshed from the L7 policy on every read.
+	l7Policy, err := l7policies.Get(networkingClient, l7policyID).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "l7policy")
+	}
+
+	d.Set("l7policy_id", l7policyID)
+	d.Set("listener_id", l7Policy.ListenerID)
+	d.Set("type", l7Rule.RuleType)
+	d.Set("compare_type", l7Rule.CompareType)
+	d.Set("tenant_id", l7Rule.TenantID)
+	d.Set("value", l7Rule.Value)
+	d.Set("key", l7Rule.Key)
+	d.Set("invert", l7Rule.Invert)
+	d.Set("admin_state_up", l7Rule.AdminStateUp)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceL7RuleV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	l7policyID := d.Get("l7policy_id").(string)
+	listenerID := d.Get("listener_id").(string)
+
+	var updateOpts l7policies.UpdateRuleOpts
+	if d.HasChange("compare_type") {
+		updateOpts.CompareType = l7policies.CompareType(d.Get("compare_type").(string))
+	}
+	if d.HasChange("value") {
+		updateOpts.Value = d.Get("value").(string)
+	}
+	if d.HasChange("key") {
+		key := d.Get("key").(string)
+		updateOpts.Key = &key
+	}
+	if d.HasChange("invert") {
+		invert := d.Get("invert").(bool)
+		updateOpts.Invert = &invert
+	}
+	if d.HasChange("admin_state_up") {
+		asu := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &asu
+	}
+
+	// Wait for LoadBalancer to become active before continuing
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	err = waitForLBV2viaListener(networkingClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating l7rule %s with options: %#v", d.Id(), updateOpts)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		_, err = l7policies.UpdateRule(networkingClient, l7policyID, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("Unable to update l7rule %s: %s", d.Id(), err)
+	}
+
+	// Wait for LoadBalancer to become active before continuing
+	err = waitForLBV2viaListener(networkingClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	return resourceL7RuleV2Read(d, meta)
+}
+
+func resourceL7RuleV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	l7policyID := d.Get("l7policy_id").(string)
+	listenerID := d.Get("listener_id").(string)
+
+	// Wait for LoadBalancer to become active before continuing
+	timeout := d.Timeout(schema.TimeoutDelete)
+	err = waitForLBV2viaListener(networkingClient, listenerID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Attempting to delete l7rule %s", d.Id())
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err = l7policies.DeleteRule(networkingClient, l7policyID, d.Id()).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return CheckDeleted(d, err, "l7rule")
+	}
+
+	// Wait for L7 Rule to delete
+	err = waitForLBV2L7Rule(networkingClient, l7policyID, d.Id(), "DELETED", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceL7RuleV2Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Invalid format specified for l7rule. Format must be <l7policy_id>/<l7rule_id>")
+	}
+
+	d.Set("l7policy_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
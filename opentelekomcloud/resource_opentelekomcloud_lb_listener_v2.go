@@ -17,6 +17,9 @@ func resourceListenerV2() *schema.Resource {
 		Read:   resourceListenerV2Read,
 		Update: resourceListenerV2Update,
 		Delete: resourceListenerV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -207,6 +210,9 @@ func resourceListenerV2Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("protocol_port", listener.ProtocolPort)
 	d.Set("admin_state_up", listener.AdminStateUp)
 	d.Set("default_pool_id", listener.DefaultPoolID)
+	if len(listener.Loadbalancers) > 0 {
+		d.Set("loadbalancer_id", listener.Loadbalancers[0].ID)
+	}
 	//d.Set("connection_limit", listener.ConnLimit)
 	if err := d.Set("sni_container_refs", listener.SniContainerRefs); err != nil {
 		return fmt.Errorf("[DEBUG] Error saving sni_container_refs to state for OpenTelekomCloud listener (%s): %s", d.Id(), err)
@@ -19,6 +19,9 @@ func resourceLoadBalancerV2() *schema.Resource {
 		Read:   resourceLoadBalancerV2Read,
 		Update: resourceLoadBalancerV2Update,
 		Delete: resourceLoadBalancerV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -95,6 +98,9 @@ func resourceLoadBalancerV2() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -145,6 +151,16 @@ func resourceLoadBalancerV2Create(d *schema.ResourceData, meta interface{}) erro
 	// If all has been successful, set the ID on the resource
 	d.SetId(lb.ID)
 
+	if len(resourceTags(d, meta)) > 0 {
+		tagManager, err := vpcTagManager(d, config, "loadbalancers")
+		if err != nil {
+			return err
+		}
+		if err := setResourceTags(d, meta, tagManager); err != nil {
+			return fmt.Errorf("Error setting tags of LoadBalancer %s: %s", lb.ID, err)
+		}
+	}
+
 	return resourceLoadBalancerV2Read(d, meta)
 }
 
@@ -185,6 +201,14 @@ func resourceLoadBalancerV2Read(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	tagManager, err := vpcTagManager(d, config, "loadbalancers")
+	if err != nil {
+		return err
+	}
+	if err := readResourceTags(d, meta, tagManager); err != nil {
+		return fmt.Errorf("Error retrieving tags of LoadBalancer %s: %s", d.Id(), err)
+	}
+
 	return nil
 }
 
@@ -237,6 +261,16 @@ func resourceLoadBalancerV2Update(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if resourceTagsChanged(d, meta) {
+		tagManager, err := vpcTagManager(d, config, "loadbalancers")
+		if err != nil {
+			return err
+		}
+		if err := setResourceTags(d, meta, tagManager); err != nil {
+			return fmt.Errorf("Error updating tags of LoadBalancer %s: %s", d.Id(), err)
+		}
+	}
+
 	return resourceLoadBalancerV2Read(d, meta)
 }
 
@@ -27,6 +27,8 @@ func TestAccLBV2LoadBalancer_basic(t *testing.T) {
 				Config: testAccLBV2LoadBalancerConfig_basic,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckLBV2LoadBalancerExists("opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1", &lb),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1", "tags.foo", "bar"),
 				),
 			},
 			resource.TestStep{
@@ -37,6 +39,10 @@ func TestAccLBV2LoadBalancer_basic(t *testing.T) {
 					resource.TestMatchResourceAttr(
 						"opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1", "vip_port_id",
 						regexp.MustCompile("^[a-f0-9-]+")),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1", "tags.%", "1"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1", "tags.foo", "baz"),
 				),
 			},
 		},
@@ -183,6 +189,11 @@ resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
   name = "loadbalancer_1"
   vip_subnet_id = "%s"
 
+  tags {
+    foo = "bar"
+    key = "value"
+  }
+
   timeouts {
     create = "5m"
     update = "5m"
@@ -197,6 +208,10 @@ resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
   admin_state_up = "true"
   vip_subnet_id = "%s"
 
+  tags {
+    foo = "baz"
+  }
+
   timeouts {
     create = "5m"
     update = "5m"
@@ -3,6 +3,7 @@ package opentelekomcloud
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -17,6 +18,9 @@ func resourceMemberV2() *schema.Resource {
 		Read:   resourceMemberV2Read,
 		Update: resourceMemberV2Update,
 		Delete: resourceMemberV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMemberV2Import,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -182,6 +186,9 @@ func resourceMemberV2Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("address", member.Address)
 	d.Set("protocol_port", member.ProtocolPort)
 	d.Set("id", member.ID)
+	if member.PoolID != "" {
+		d.Set("pool_id", member.PoolID)
+	}
 	d.Set("region", GetRegion(d, config))
 
 	return nil
@@ -267,3 +274,15 @@ func resourceMemberV2Delete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func resourceMemberV2Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Invalid format specified for member. Format must be <pool_id>/<member_id>")
+	}
+
+	d.Set("pool_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,319 @@
+package opentelekomcloud
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+)
+
+func resourceMembersV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMembersV2Create,
+		Read:   resourceMembersV2Read,
+		Update: resourceMembersV2Update,
+		Delete: resourceMembersV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"pool_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"member": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      resourceMembersV2MemberHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"address": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"protocol_port": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						"weight": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								value := v.(int)
+								if value < 1 {
+									errors = append(errors, fmt.Errorf(
+										"Only numbers greater than 0 are supported values for 'weight'"))
+								}
+								return
+							},
+						},
+
+						"subnet_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"admin_state_up": &schema.Schema{
+							Type:     schema.TypeBool,
+							Default:  true,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceMembersV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	poolID := d.Get("pool_id").(string)
+	d.SetId(poolID)
+
+	members := d.Get("member").(*schema.Set)
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if err := updateLBMembersV2(networkingClient, poolID, &schema.Set{F: resourceMembersV2MemberHash}, members, timeout); err != nil {
+		return fmt.Errorf("Error creating members: %s", err)
+	}
+
+	return resourceMembersV2Read(d, meta)
+}
+
+func resourceMembersV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	allPages, err := pools.ListMembers(networkingClient, d.Id(), pools.ListMembersOpts{}).AllPages()
+	if err != nil {
+		return CheckDeleted(d, err, "members")
+	}
+
+	allMembers, err := pools.ExtractMembers(allPages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve members of pool %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Retrieved %d members of pool %s", len(allMembers), d.Id())
+
+	members := make([]map[string]interface{}, 0, len(allMembers))
+	for _, m := range allMembers {
+		members = append(members, map[string]interface{}{
+			"id":             m.ID,
+			"name":           m.Name,
+			"address":        m.Address,
+			"protocol_port":  m.ProtocolPort,
+			"weight":         m.Weight,
+			"subnet_id":      m.SubnetID,
+			"admin_state_up": m.AdminStateUp,
+		})
+	}
+
+	if err := d.Set("member", members); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving member to state for OpenTelekomCloud pool (%s): %s", d.Id(), err)
+	}
+	d.Set("pool_id", d.Id())
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceMembersV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	if d.HasChange("member") {
+		o, n := d.GetChange("member")
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		if err := updateLBMembersV2(networkingClient, d.Id(), o.(*schema.Set), n.(*schema.Set), timeout); err != nil {
+			return fmt.Errorf("Unable to update members of pool %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceMembersV2Read(d, meta)
+}
+
+func resourceMembersV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	members := d.Get("member").(*schema.Set)
+	timeout := d.Timeout(schema.TimeoutDelete)
+	err = updateLBMembersV2(networkingClient, d.Id(), members, &schema.Set{F: resourceMembersV2MemberHash}, timeout)
+	if err != nil {
+		return CheckDeleted(d, err, "members")
+	}
+
+	return nil
+}
+
+// updateLBMembersV2 turns the old members of a pool into the new ones. The
+// members only in the old set are deleted, the ones only in the new set are
+// created, and a member whose weight or admin_state_up changed is updated in
+// place.
+func updateLBMembersV2(networkingClient *gophercloud.ServiceClient, poolID string, oldMembers, newMembers *schema.Set, timeout time.Duration) error {
+	removed := oldMembers.Difference(newMembers).List()
+	added := newMembers.Difference(oldMembers).List()
+	log.Printf("[DEBUG] Pool %s members: %d to remove or update, %d to add or update", poolID, len(removed), len(added))
+
+	// updated holds the new settings of the members updated in place, by ID,
+	// and matched the hashes of these settings.
+	updated := make(map[string]map[string]interface{})
+	matched := make(map[int]bool)
+	for _, raw := range removed {
+		old := raw.(map[string]interface{})
+		if m := findLBMemberV2(added, old); m != nil {
+			updated[old["id"].(string)] = m
+			matched[resourceMembersV2MemberHash(m)] = true
+			continue
+		}
+
+		memberID := old["id"].(string)
+		log.Printf("[DEBUG] Deleting member %s of pool %s", memberID, poolID)
+		err := retryLBMemberV2(networkingClient, poolID, timeout, func() error {
+			err := pools.DeleteMember(networkingClient, poolID, memberID).ExtractErr()
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return nil
+			}
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("Error deleting member %s: %s", memberID, err)
+		}
+	}
+
+	for memberID, m := range updated {
+		adminStateUp := m["admin_state_up"].(bool)
+		updateOpts := pools.UpdateMemberOpts{
+			Weight:       m["weight"].(int),
+			AdminStateUp: &adminStateUp,
+		}
+		log.Printf("[DEBUG] Updating member %s of pool %s with options: %#v", memberID, poolID, updateOpts)
+		err := retryLBMemberV2(networkingClient, poolID, timeout, func() error {
+			_, err := pools.UpdateMember(networkingClient, poolID, memberID, updateOpts).Extract()
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating member %s: %s", memberID, err)
+		}
+	}
+
+	for _, raw := range added {
+		m := raw.(map[string]interface{})
+		if matched[resourceMembersV2MemberHash(m)] {
+			continue
+		}
+
+		adminStateUp := m["admin_state_up"].(bool)
+		createOpts := pools.CreateMemberOpts{
+			Name:         m["name"].(string),
+			Address:      m["address"].(string),
+			ProtocolPort: m["protocol_port"].(int),
+			Weight:       m["weight"].(int),
+			SubnetID:     m["subnet_id"].(string),
+			AdminStateUp: &adminStateUp,
+		}
+		log.Printf("[DEBUG] Creating member of pool %s with options: %#v", poolID, createOpts)
+		err := retryLBMemberV2(networkingClient, poolID, timeout, func() error {
+			_, err := pools.CreateMember(networkingClient, poolID, createOpts).Extract()
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("Error creating member %s:%d: %s", createOpts.Address, createOpts.ProtocolPort, err)
+		}
+	}
+
+	// Wait for LB to become ACTIVE again
+	return waitForLBV2viaPool(networkingClient, poolID, "ACTIVE", timeout)
+}
+
+// retryLBMemberV2 waits for the load balancer of the pool to become active and
+// runs f, retrying while the load balancer is busy.
+func retryLBMemberV2(networkingClient *gophercloud.ServiceClient, poolID string, timeout time.Duration, f func() error) error {
+	err := waitForLBV2viaPool(networkingClient, poolID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	return resource.Retry(timeout, func() *resource.RetryError {
+		if err := f(); err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+}
+
+// findLBMemberV2 returns the member of members which only differs from old by
+// its weight or admin_state_up, or nil.
+func findLBMemberV2(members []interface{}, old map[string]interface{}) map[string]interface{} {
+	for _, raw := range members {
+		m := raw.(map[string]interface{})
+		if m["address"] == old["address"] && m["protocol_port"] == old["protocol_port"] &&
+			m["subnet_id"] == old["subnet_id"] && m["name"] == old["name"] {
+			return m
+		}
+	}
+	return nil
+}
+
+func resourceMembersV2MemberHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["address"].(string)))
+	buf.WriteString(fmt.Sprintf("%d-", m["protocol_port"].(int)))
+	buf.WriteString(fmt.Sprintf("%d-", m["weight"].(int)))
+	buf.WriteString(fmt.Sprintf("%s-", m["name"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["subnet_id"].(string)))
+	buf.WriteString(fmt.Sprintf("%t-", m["admin_state_up"].(bool)))
+
+	return hashcode.String(buf.String())
+}
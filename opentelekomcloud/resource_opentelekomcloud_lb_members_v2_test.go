@@ -0,0 +1,196 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccLBV2Members_basic(t *testing.T) {
+	var members []pools.Member
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2MembersDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: TestAccLBV2MembersConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2MembersExists("opentelekomcloud_lb_members_v2.members_1", &members),
+					resource.TestCheckResourceAttr("opentelekomcloud_lb_members_v2.members_1", "member.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: TestAccLBV2MembersConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2MembersExists("opentelekomcloud_lb_members_v2.members_1", &members),
+					resource.TestCheckResourceAttr("opentelekomcloud_lb_members_v2.members_1", "member.#", "2"),
+					testAccCheckLBV2MembersWeight(&members, "10.0.0.11", 15),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBV2MembersDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_lb_members_v2" {
+			continue
+		}
+
+		allPages, err := pools.ListMembers(networkingClient, rs.Primary.ID, pools.ListMembersOpts{}).AllPages()
+		if err != nil {
+			// The pool is gone as well
+			continue
+		}
+
+		allMembers, err := pools.ExtractMembers(allPages)
+		if err != nil {
+			return err
+		}
+
+		if len(allMembers) > 0 {
+			return fmt.Errorf("Members still exist in pool: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBV2MembersExists(n string, members *[]pools.Member) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+		}
+
+		allPages, err := pools.ListMembers(networkingClient, rs.Primary.ID, pools.ListMembersOpts{}).AllPages()
+		if err != nil {
+			return err
+		}
+
+		found, err := pools.ExtractMembers(allPages)
+		if err != nil {
+			return err
+		}
+
+		if len(found) == 0 {
+			return fmt.Errorf("Members not found")
+		}
+
+		*members = found
+
+		return nil
+	}
+}
+
+func testAccCheckLBV2MembersWeight(members *[]pools.Member, address string, weight int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, m := range *members {
+			if m.Address != address {
+				continue
+			}
+			if m.Weight != weight {
+				return fmt.Errorf("Bad weight for member %s: expected %d, got %d", address, weight, m.Weight)
+			}
+			return nil
+		}
+
+		return fmt.Errorf("Member %s not found", address)
+	}
+}
+
+var testAccLBV2MembersConfig = fmt.Sprintf(`
+resource "opentelekomcloud_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "%s"
+}
+
+resource "opentelekomcloud_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "HTTP"
+  protocol_port = 8080
+  loadbalancer_id = "${opentelekomcloud_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "opentelekomcloud_lb_pool_v2" "pool_1" {
+  name = "pool_1"
+  protocol = "HTTP"
+  lb_method = "ROUND_ROBIN"
+  listener_id = "${opentelekomcloud_lb_listener_v2.listener_1.id}"
+}
+`, OS_SUBNET_ID)
+
+var TestAccLBV2MembersConfig_basic = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_members_v2" "members_1" {
+  pool_id = "${opentelekomcloud_lb_pool_v2.pool_1.id}"
+
+  member {
+    address = "10.0.0.10"
+    protocol_port = 8080
+    subnet_id = "%s"
+  }
+
+  member {
+    address = "10.0.0.11"
+    protocol_port = 8080
+    subnet_id = "%s"
+  }
+
+  timeouts {
+    create = "5m"
+    update = "5m"
+    delete = "5m"
+  }
+}
+`, testAccLBV2MembersConfig, OS_SUBNET_ID, OS_SUBNET_ID)
+
+var TestAccLBV2MembersConfig_update = fmt.Sprintf(`
+%s
+
+resource "opentelekomcloud_lb_members_v2" "members_1" {
+  pool_id = "${opentelekomcloud_lb_pool_v2.pool_1.id}"
+
+  member {
+    address = "10.0.0.11"
+    protocol_port = 8080
+    weight = 15
+    subnet_id = "%s"
+  }
+
+  member {
+    address = "10.0.0.12"
+    protocol_port = 8080
+    admin_state_up = false
+    subnet_id = "%s"
+  }
+
+  timeouts {
+    create = "5m"
+    update = "5m"
+    delete = "5m"
+  }
+}
+`, testAccLBV2MembersConfig, OS_SUBNET_ID, OS_SUBNET_ID)
@@ -17,6 +17,9 @@ func resourcePoolV2() *schema.Resource {
 		Read:   resourcePoolV2Read,
 		Update: resourcePoolV2Update,
 		Delete: resourcePoolV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -67,6 +70,7 @@ func resourcePoolV2() *schema.Resource {
 			"loadbalancer_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -74,6 +78,7 @@ func resourcePoolV2() *schema.Resource {
 			"listener_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -251,9 +256,25 @@ func resourcePoolV2Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("admin_state_up", pool.AdminStateUp)
 	d.Set("name", pool.Name)
 	d.Set("id", pool.ID)
+
+	if len(pool.Loadbalancers) > 0 {
+		d.Set("loadbalancer_id", pool.Loadbalancers[0].ID)
+	}
+	if len(pool.Listeners) > 0 {
+		d.Set("listener_id", pool.Listeners[0].ID)
+	}
+
 	log.Printf("[DEBUG] pool persistence: %+v", pool.Persistence)
-	// UNDONE: This needs to check for failure, and it currently fails...
-	//d.Set("persistence", pool.Persistence);
+	var persistence []map[string]interface{}
+	if pool.Persistence.Type != "" {
+		persistence = append(persistence, map[string]interface{}{
+			"type":        pool.Persistence.Type,
+			"cookie_name": pool.Persistence.CookieName,
+		})
+	}
+	if err := d.Set("persistence", persistence); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving persistence to state for OpenTelekomCloud pool (%s): %s", d.Id(), err)
+	}
 	d.Set("region", GetRegion(d, config))
 
 	return nil
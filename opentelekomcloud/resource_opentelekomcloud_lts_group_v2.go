@@ -0,0 +1,118 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk/openstack/lts/v2/loggroups"
+)
+
+func resourceLTSGroupV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLTSGroupV2Create,
+		Read:   resourceLTSGroupV2Read,
+		Update: resourceLTSGroupV2Update,
+		Delete: resourceLTSGroupV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ttl_in_days": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  7,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateIntRange(v, k, 1, 30)
+				},
+			},
+		},
+	}
+}
+
+func resourceLTSGroupV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.ltsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud lts client: %s", err)
+	}
+
+	createOpts := &loggroups.CreateOpts{
+		LogGroupName: d.Get("group_name").(string),
+		TTLInDays:    d.Get("ttl_in_days").(int),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	id, err := loggroups.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud log group: %s", err)
+	}
+	log.Printf("[INFO] Log group ID: %s", id)
+
+	d.SetId(id)
+
+	return resourceLTSGroupV2Read(d, meta)
+}
+
+func resourceLTSGroupV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.ltsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud lts client: %s", err)
+	}
+
+	group, err := loggroups.Get(client, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "log group")
+	}
+	log.Printf("[DEBUG] Log group %s: %+v", d.Id(), group)
+
+	d.Set("group_name", group.Name)
+	d.Set("ttl_in_days", group.TTLInDays)
+
+	return nil
+}
+
+func resourceLTSGroupV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.ltsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud lts client: %s", err)
+	}
+
+	if d.HasChange("ttl_in_days") {
+		updateOpts := loggroups.UpdateOpts{
+			TTLInDays: d.Get("ttl_in_days").(int),
+		}
+		log.Printf("[DEBUG] Updating log group %s with options: %#v", d.Id(), updateOpts)
+		_, err = loggroups.Update(client, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenTelekomCloud log group: %s", err)
+		}
+	}
+
+	return resourceLTSGroupV2Read(d, meta)
+}
+
+func resourceLTSGroupV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.ltsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud lts client: %s", err)
+	}
+
+	err = loggroups.Delete(client, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "log group")
+	}
+
+	log.Printf("[DEBUG] Log group %s deleted", d.Id())
+	d.SetId("")
+	return nil
+}
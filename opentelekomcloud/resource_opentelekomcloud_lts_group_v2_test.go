@@ -0,0 +1,101 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/huaweicloud/golangsdk/openstack/lts/v2/loggroups"
+)
+
+func TestAccLTSGroupV2_basic(t *testing.T) {
+	var group loggroups.LogGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLTSGroupV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLTSGroupV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLTSGroupV2Exists("opentelekomcloud_lts_group_v2.group_1", &group),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lts_group_v2.group_1", "group_name", "lts_group_1"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lts_group_v2.group_1", "ttl_in_days", "7"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccLTSGroupV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lts_group_v2.group_1", "ttl_in_days", "30"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLTSGroupV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	client, err := config.ltsV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud lts client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_lts_group_v2" {
+			continue
+		}
+
+		_, err := loggroups.Get(client, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Log group still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLTSGroupV2Exists(n string, group *loggroups.LogGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		client, err := config.ltsV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud lts client: %s", err)
+		}
+
+		found, err := loggroups.Get(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*group = *found
+
+		return nil
+	}
+}
+
+const testAccLTSGroupV2_basic = `
+resource "opentelekomcloud_lts_group_v2" "group_1" {
+  group_name = "lts_group_1"
+}
+`
+
+const testAccLTSGroupV2_update = `
+resource "opentelekomcloud_lts_group_v2" "group_1" {
+  group_name  = "lts_group_1"
+  ttl_in_days = 30
+}
+`
@@ -0,0 +1,244 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk/openstack/lts/v3/accessconfigs"
+	"github.com/huaweicloud/golangsdk/openstack/lts/v3/hostgroups"
+)
+
+func resourceLTSIngestionV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLTSIngestionV3Create,
+		Read:   resourceLTSIngestionV3Read,
+		Update: resourceLTSIngestionV3Update,
+		Delete: resourceLTSIngestionV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceLTSIngestionV3Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"topic_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"host_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "linux",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"linux", "windows"})
+				},
+			},
+			"host_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"paths": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"black_paths": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"host_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getLTSIngestionV3List(d *schema.ResourceData, name string) []string {
+	var list []string
+	for _, v := range d.Get(name).(*schema.Set).List() {
+		list = append(list, v.(string))
+	}
+	return list
+}
+
+func getLTSIngestionV3Detail(d *schema.ResourceData) accessconfigs.Detail {
+	return accessconfigs.Detail{
+		Paths:      getLTSIngestionV3List(d, "paths"),
+		BlackPaths: getLTSIngestionV3List(d, "black_paths"),
+	}
+}
+
+func resourceLTSIngestionV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.ltsV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud lts client: %s", err)
+	}
+
+	// the hosts are collected in a host group of the same name, which is
+	// owned by this resource.
+	hostGroupOpts := hostgroups.CreateOpts{
+		HostGroupName: d.Get("name").(string),
+		HostGroupType: d.Get("host_type").(string),
+		HostIDList:    getLTSIngestionV3List(d, "host_ids"),
+	}
+	log.Printf("[DEBUG] Create Options: %#v", hostGroupOpts)
+	group, err := hostgroups.Create(client, hostGroupOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud lts host group: %s", err)
+	}
+	d.Set("host_group_id", group.ID)
+
+	createOpts := accessconfigs.CreateOpts{
+		AccessConfigName:   d.Get("name").(string),
+		AccessConfigType:   "AGENT",
+		AccessConfigDetail: getLTSIngestionV3Detail(d),
+		LogInfo: accessconfigs.LogInfo{
+			LogGroupID:  d.Get("group_id").(string),
+			LogStreamID: d.Get("topic_id").(string),
+		},
+		HostGroupInfo: &accessconfigs.HostGroupInfo{
+			HostGroupIDList: []string{group.ID},
+		},
+	}
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	v, err := accessconfigs.Create(client, createOpts).Extract()
+	if err != nil {
+		if delErr := hostgroups.Delete(client, group.ID).ExtractErr(); delErr != nil {
+			log.Printf("[WARN] Error deleting lts host group %s: %s", group.ID, delErr)
+		}
+		return fmt.Errorf("Error creating OpenTelekomCloud lts ingestion: %s", err)
+	}
+	log.Printf("[INFO] Lts ingestion ID: %s", v.ID)
+
+	d.SetId(v.ID)
+
+	return resourceLTSIngestionV3Read(d, meta)
+}
+
+func resourceLTSIngestionV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.ltsV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud lts client: %s", err)
+	}
+
+	v, err := accessconfigs.Get(client, d.Get("name").(string))
+	if err != nil {
+		return CheckDeleted(d, err, "lts ingestion")
+	}
+	log.Printf("[DEBUG] Lts ingestion %s: %+v", d.Id(), v)
+
+	d.Set("name", v.Name)
+	d.Set("group_id", v.LogInfo.LogGroupID)
+	d.Set("topic_id", v.LogInfo.LogStreamID)
+	d.Set("paths", v.Detail.Paths)
+	d.Set("black_paths", v.Detail.BlackPaths)
+	if len(v.HostGroupInfo.HostGroupIDList) > 0 {
+		d.Set("host_group_id", v.HostGroupInfo.HostGroupIDList[0])
+	}
+
+	group, err := hostgroups.Get(client, d.Get("host_group_id").(string))
+	if err != nil {
+		return fmt.Errorf("Error retrieving OpenTelekomCloud lts host group: %s", err)
+	}
+	d.Set("host_type", group.Type)
+	d.Set("host_ids", group.HostIDList)
+
+	return nil
+}
+
+func resourceLTSIngestionV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.ltsV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud lts client: %s", err)
+	}
+
+	if d.HasChange("host_ids") {
+		hostIDs := getLTSIngestionV3List(d, "host_ids")
+		updateOpts := hostgroups.UpdateOpts{
+			HostGroupID: d.Get("host_group_id").(string),
+			HostIDList:  &hostIDs,
+		}
+		log.Printf("[DEBUG] Updating lts host group %s with options: %#v", updateOpts.HostGroupID, updateOpts)
+		_, err = hostgroups.Update(client, updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenTelekomCloud lts host group: %s", err)
+		}
+	}
+
+	if d.HasChange("paths") || d.HasChange("black_paths") {
+		detail := getLTSIngestionV3Detail(d)
+		updateOpts := accessconfigs.UpdateOpts{
+			AccessConfigID:     d.Id(),
+			AccessConfigDetail: &detail,
+		}
+		log.Printf("[DEBUG] Updating lts ingestion %s with options: %#v", d.Id(), updateOpts)
+		_, err = accessconfigs.Update(client, updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenTelekomCloud lts ingestion: %s", err)
+		}
+	}
+
+	return resourceLTSIngestionV3Read(d, meta)
+}
+
+func resourceLTSIngestionV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.ltsV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud lts client: %s", err)
+	}
+
+	err = accessconfigs.Delete(client, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "lts ingestion")
+	}
+
+	err = hostgroups.Delete(client, d.Get("host_group_id").(string)).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "lts host group")
+	}
+
+	log.Printf("[DEBUG] Lts ingestion %s deleted", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// resourceLTSIngestionV3Import looks up the ingestion by the name given as
+// import ID, since the service can only be queried by name.
+func resourceLTSIngestionV3Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	client, err := config.ltsV3Client(GetRegion(d, config))
+	if err != nil {
+		return nil, fmt.Errorf("Error creating OpenTelekomCloud lts client: %s", err)
+	}
+
+	v, err := accessconfigs.Get(client, d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving OpenTelekomCloud lts ingestion %s: %s", d.Id(), err)
+	}
+
+	d.SetId(v.ID)
+	d.Set("name", v.Name)
+
+	return []*schema.ResourceData{d}, nil
+}
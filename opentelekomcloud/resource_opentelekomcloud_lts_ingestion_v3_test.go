@@ -0,0 +1,112 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/huaweicloud/golangsdk/openstack/lts/v3/accessconfigs"
+)
+
+func TestAccLTSIngestionV3_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLTSIngestionV3Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLTSIngestionV3_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"opentelekomcloud_lts_ingestion_v3.ingestion_1", "host_group_id"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lts_ingestion_v3.ingestion_1", "paths.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccLTSIngestionV3_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lts_ingestion_v3.ingestion_1", "paths.#", "2"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lts_ingestion_v3.ingestion_1", "black_paths.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLTSIngestionV3Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	client, err := config.ltsV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud lts client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_lts_ingestion_v3" {
+			continue
+		}
+
+		_, err := accessconfigs.Get(client, rs.Primary.Attributes["name"])
+		if err == nil {
+			return fmt.Errorf("Lts ingestion still exists")
+		}
+	}
+
+	return nil
+}
+
+var testAccLTSIngestionV3_basic = fmt.Sprintf(`
+resource "opentelekomcloud_compute_instance_v2" "vm_1" {
+  name = "instance_1"
+  network {
+    uuid = "%s"
+  }
+}
+
+resource "opentelekomcloud_lts_group_v2" "group_1" {
+  group_name = "lts_group_1"
+}
+
+resource "opentelekomcloud_lts_topic_v2" "topic_1" {
+  group_id   = "${opentelekomcloud_lts_group_v2.group_1.id}"
+  topic_name = "lts_topic_1"
+}
+
+resource "opentelekomcloud_lts_ingestion_v3" "ingestion_1" {
+  name     = "lts_ingestion_1"
+  group_id = "${opentelekomcloud_lts_group_v2.group_1.id}"
+  topic_id = "${opentelekomcloud_lts_topic_v2.topic_1.id}"
+  host_ids = ["${opentelekomcloud_compute_instance_v2.vm_1.id}"]
+  paths    = ["/var/log/messages"]
+}
+`, OS_NETWORK_ID)
+
+var testAccLTSIngestionV3_update = fmt.Sprintf(`
+resource "opentelekomcloud_compute_instance_v2" "vm_1" {
+  name = "instance_1"
+  network {
+    uuid = "%s"
+  }
+}
+
+resource "opentelekomcloud_lts_group_v2" "group_1" {
+  group_name = "lts_group_1"
+}
+
+resource "opentelekomcloud_lts_topic_v2" "topic_1" {
+  group_id   = "${opentelekomcloud_lts_group_v2.group_1.id}"
+  topic_name = "lts_topic_1"
+}
+
+resource "opentelekomcloud_lts_ingestion_v3" "ingestion_1" {
+  name        = "lts_ingestion_1"
+  group_id    = "${opentelekomcloud_lts_group_v2.group_1.id}"
+  topic_id    = "${opentelekomcloud_lts_topic_v2.topic_1.id}"
+  host_ids    = ["${opentelekomcloud_compute_instance_v2.vm_1.id}"]
+  paths       = ["/var/log/messages", "/var/log/app/*.log"]
+  black_paths = ["/var/log/app/debug.log"]
+}
+`, OS_NETWORK_ID)
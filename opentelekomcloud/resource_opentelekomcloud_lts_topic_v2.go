@@ -0,0 +1,113 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk/openstack/lts/v2/logtopics"
+)
+
+func resourceLTSTopicV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLTSTopicV2Create,
+		Read:   resourceLTSTopicV2Read,
+		Delete: resourceLTSTopicV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceLTSTopicV2Import,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"topic_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"index_enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLTSTopicV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.ltsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud lts client: %s", err)
+	}
+
+	createOpts := &logtopics.CreateOpts{
+		LogTopicName: d.Get("topic_name").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	id, err := logtopics.Create(client, d.Get("group_id").(string), createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud log topic: %s", err)
+	}
+	log.Printf("[INFO] Log topic ID: %s", id)
+
+	d.SetId(id)
+
+	return resourceLTSTopicV2Read(d, meta)
+}
+
+func resourceLTSTopicV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.ltsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud lts client: %s", err)
+	}
+
+	topic, err := logtopics.Get(client, d.Get("group_id").(string), d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "log topic")
+	}
+	log.Printf("[DEBUG] Log topic %s: %+v", d.Id(), topic)
+
+	d.Set("topic_name", topic.Name)
+	d.Set("index_enabled", topic.IndexEnabled)
+
+	return nil
+}
+
+func resourceLTSTopicV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.ltsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud lts client: %s", err)
+	}
+
+	err = logtopics.Delete(client, d.Get("group_id").(string), d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "log topic")
+	}
+
+	log.Printf("[DEBUG] Log topic %s deleted", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceLTSTopicV2Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		err := fmt.Errorf("Invalid format specified for LTS Topic. Format must be <group id>/<topic id>")
+		return nil, err
+	}
+
+	groupID := parts[0]
+	topicID := parts[1]
+
+	d.SetId(topicID)
+	d.Set("group_id", groupID)
+
+	return []*schema.ResourceData{d}, nil
+}
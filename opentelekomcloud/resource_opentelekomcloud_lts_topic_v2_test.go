@@ -0,0 +1,90 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/huaweicloud/golangsdk/openstack/lts/v2/logtopics"
+)
+
+func TestAccLTSTopicV2_basic(t *testing.T) {
+	var topic logtopics.LogTopic
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLTSTopicV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLTSTopicV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLTSTopicV2Exists("opentelekomcloud_lts_topic_v2.topic_1", &topic),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_lts_topic_v2.topic_1", "topic_name", "lts_topic_1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLTSTopicV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	client, err := config.ltsV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud lts client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_lts_topic_v2" {
+			continue
+		}
+
+		_, err := logtopics.Get(client, rs.Primary.Attributes["group_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Log topic still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLTSTopicV2Exists(n string, topic *logtopics.LogTopic) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		client, err := config.ltsV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud lts client: %s", err)
+		}
+
+		found, err := logtopics.Get(client, rs.Primary.Attributes["group_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*topic = *found
+
+		return nil
+	}
+}
+
+const testAccLTSTopicV2_basic = `
+resource "opentelekomcloud_lts_group_v2" "group_1" {
+  group_name = "lts_group_1"
+}
+
+resource "opentelekomcloud_lts_topic_v2" "topic_1" {
+  group_id   = "${opentelekomcloud_lts_group_v2.group_1.id}"
+  topic_name = "lts_topic_1"
+}
+`
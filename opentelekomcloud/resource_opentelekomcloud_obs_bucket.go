@@ -0,0 +1,480 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-opentelekomcloud/opentelekomcloud/obs"
+)
+
+func resourceObsBucket() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceObsBucketCreate,
+		Read:   resourceObsBucketRead,
+		Update: resourceObsBucketUpdate,
+		Delete: resourceObsBucketDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceObsBucketImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"storage_class": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "STANDARD",
+				ValidateFunc: validation.StringInSlice([]string{
+					"STANDARD", "WARM", "COLD",
+				}, false),
+			},
+
+			"acl": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "private",
+				ValidateFunc: validation.StringInSlice([]string{
+					"private", "public-read", "public-read-write", "log-delivery-write",
+				}, false),
+			},
+
+			"versioning": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"quota": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"tags_all": tagsAllSchema(),
+
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"bucket_domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceObsBucketCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	obsClient, err := config.newObjectStorageClient(region)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	createOpts := &obs.CreateBucketInput{
+		Bucket:       bucket,
+		ACL:          obs.AclType(d.Get("acl").(string)),
+		StorageClass: obs.StorageClassType(d.Get("storage_class").(string)),
+		Location:     region,
+	}
+
+	log.Printf("[DEBUG] OBS bucket create options: %#v", createOpts)
+	_, err = obsClient.CreateBucket(createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating OBS bucket %s: %s", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	if d.Get("versioning").(bool) {
+		if err := resourceObsBucketVersioningUpdate(obsClient, d); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("quota").(int) > 0 {
+		if err := resourceObsBucketQuotaUpdate(obsClient, d); err != nil {
+			return err
+		}
+	}
+
+	if len(resourceTags(d, meta)) > 0 {
+		if err := resourceObsBucketTagsUpdate(obsClient, d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceObsBucketRead(d, meta)
+}
+
+func resourceObsBucketUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	obsClient, err := config.newObjectStorageClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud OBS client: %s", err)
+	}
+
+	if d.HasChange("acl") {
+		if err := resourceObsBucketAclUpdate(obsClient, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("storage_class") {
+		if err := resourceObsBucketStorageClassUpdate(obsClient, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("versioning") {
+		if err := resourceObsBucketVersioningUpdate(obsClient, d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("quota") {
+		if err := resourceObsBucketQuotaUpdate(obsClient, d); err != nil {
+			return err
+		}
+	}
+
+	if resourceTagsChanged(d, meta) {
+		if err := resourceObsBucketTagsUpdate(obsClient, d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceObsBucketRead(d, meta)
+}
+
+func resourceObsBucketRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	obsClient, err := config.newObjectStorageClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud OBS client: %s", err)
+	}
+
+	bucket := d.Id()
+	metadata, err := obsClient.GetBucketMetadata(bucket)
+	if err != nil {
+		if isObsNotFound(err) {
+			log.Printf("[WARN] OBS bucket (%s) not found, removing from state", bucket)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading OBS bucket %s: %s", bucket, err)
+	}
+	log.Printf("[DEBUG] Retrieved OBS bucket %s: %#v", bucket, metadata)
+
+	d.Set("bucket", bucket)
+	d.Set("bucket_domain_name", obsClient.BucketDomainName(bucket))
+	if metadata.Location != "" {
+		d.Set("region", metadata.Location)
+	}
+	if metadata.StorageClass != "" {
+		d.Set("storage_class", string(metadata.StorageClass))
+	} else {
+		d.Set("storage_class", string(obs.StorageClassStandard))
+	}
+
+	versioning, err := obsClient.GetBucketVersioning(bucket)
+	if err != nil {
+		return fmt.Errorf("Error reading versioning of OBS bucket %s: %s", bucket, err)
+	}
+	d.Set("versioning", versioning.Status == obs.VersioningStatusEnabled)
+
+	quota, err := obsClient.GetBucketQuota(bucket)
+	if err != nil {
+		return fmt.Errorf("Error reading quota of OBS bucket %s: %s", bucket, err)
+	}
+	d.Set("quota", quota.Quota)
+
+	acl, err := obsClient.GetBucketAcl(bucket)
+	if err != nil {
+		return fmt.Errorf("Error reading acl of OBS bucket %s: %s", bucket, err)
+	}
+	if canned := obsBucketCannedAcl(&acl.AccessControlPolicy); canned != "" {
+		d.Set("acl", string(canned))
+	} else {
+		log.Printf("[WARN] The acl of OBS bucket %s is not a canned acl: %#v", bucket, acl.Grants)
+		d.Set("acl", "")
+	}
+
+	tags := make(map[string]string)
+	tagging, err := obsClient.GetBucketTagging(bucket)
+	if err != nil {
+		if !isObsNotFound(err) {
+			return fmt.Errorf("Error reading tags of OBS bucket %s: %s", bucket, err)
+		}
+	} else {
+		tags = tagsToMapOBS(tagging.Tags)
+	}
+	if err := setStateTags(d, meta, tags); err != nil {
+		return fmt.Errorf("Error saving tags of OBS bucket %s: %s", bucket, err)
+	}
+
+	return nil
+}
+
+func resourceObsBucketDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	obsClient, err := config.newObjectStorageClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud OBS client: %s", err)
+	}
+
+	bucket := d.Id()
+	log.Printf("[DEBUG] Deleting OBS bucket: %s", bucket)
+	_, err = obsClient.DeleteBucket(bucket)
+	if err != nil {
+		if isObsNotFound(err) {
+			return nil
+		}
+		obsError, ok := err.(obs.ObsError)
+		if ok && obsError.Code == "BucketNotEmpty" && d.Get("force_destroy").(bool) {
+			log.Printf("[DEBUG] OBS bucket %s is not empty, deleting all objects", bucket)
+			if err := deleteAllObsBucketObjects(obsClient, bucket); err != nil {
+				return err
+			}
+			return resourceObsBucketDelete(d, meta)
+		}
+		return fmt.Errorf("Error deleting OBS bucket %s: %s", bucket, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceObsBucketImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("bucket", d.Id())
+	d.Set("force_destroy", false)
+	return []*schema.ResourceData{d}, nil
+}
+
+// obsBucketCannedAcl returns the canned ACL which grants the permissions of
+// policy, or "" if the grants do not match one. The grants of the
+// bucket-owner-full-control ACL only apply to objects, it reads as private.
+func obsBucketCannedAcl(policy *obs.AccessControlPolicy) obs.AclType {
+	var everyone, logDelivery []string
+	delivered := false
+	for _, g := range policy.Grants {
+		switch {
+		case g.Grantee.ID != "" && g.Grantee.ID == policy.Owner.ID:
+			if g.Permission != obs.PermissionFullControl {
+				return ""
+			}
+		case g.Grantee.Canned == obs.GroupEveryone || strings.HasSuffix(g.Grantee.URI, "/AllUsers"):
+			everyone = append(everyone, string(g.Permission))
+			delivered = delivered || g.Delivered
+		case g.Grantee.Canned == "LogDelivery" || strings.HasSuffix(g.Grantee.URI, "/LogDelivery"):
+			logDelivery = append(logDelivery, string(g.Permission))
+		default:
+			return ""
+		}
+	}
+	sort.Strings(everyone)
+	sort.Strings(logDelivery)
+
+	permissions := strings.Join(everyone, ",") + "|" + strings.Join(logDelivery, ",")
+	switch {
+	case permissions == "|":
+		return obs.AclPrivate
+	case permissions == "READ|" && delivered:
+		return obs.AclPublicReadDelivered
+	case permissions == "READ|":
+		return obs.AclPublicRead
+	case permissions == "READ,WRITE|" && delivered:
+		return obs.AclPublicReadWriteDelivered
+	case permissions == "READ,WRITE|":
+		return obs.AclPublicReadWrite
+	case permissions == "|READ_ACP,WRITE":
+		return obs.AclLogDeliveryWrite
+	}
+	return ""
+}
+
+func resourceObsBucketAclUpdate(obsClient *obs.ObsClient, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	acl := d.Get("acl").(string)
+
+	log.Printf("[DEBUG] OBS bucket %s, set acl: %s", bucket, acl)
+	_, err := obsClient.SetBucketAcl(&obs.SetBucketAclInput{
+		Bucket: bucket,
+		ACL:    obs.AclType(acl),
+	})
+	if err != nil {
+		return fmt.Errorf("Error setting acl of OBS bucket %s: %s", bucket, err)
+	}
+	return nil
+}
+
+func resourceObsBucketStorageClassUpdate(obsClient *obs.ObsClient, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	storageClass := d.Get("storage_class").(string)
+
+	log.Printf("[DEBUG] OBS bucket %s, set storage class: %s", bucket, storageClass)
+	_, err := obsClient.SetBucketStoragePolicy(&obs.SetBucketStoragePolicyInput{
+		Bucket:       bucket,
+		StorageClass: obs.StorageClassType(storageClass),
+	})
+	if err != nil {
+		return fmt.Errorf("Error setting storage class of OBS bucket %s: %s", bucket, err)
+	}
+	return nil
+}
+
+func resourceObsBucketVersioningUpdate(obsClient *obs.ObsClient, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	input := &obs.SetBucketVersioningInput{
+		Bucket: bucket,
+	}
+	if d.Get("versioning").(bool) {
+		input.Status = obs.VersioningStatusEnabled
+	} else {
+		input.Status = obs.VersioningStatusSuspended
+	}
+
+	log.Printf("[DEBUG] OBS bucket %s, set versioning: %s", bucket, input.Status)
+	_, err := obsClient.SetBucketVersioning(input)
+	if err != nil {
+		return fmt.Errorf("Error setting versioning of OBS bucket %s: %s", bucket, err)
+	}
+	return nil
+}
+
+func resourceObsBucketQuotaUpdate(obsClient *obs.ObsClient, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	input := &obs.SetBucketQuotaInput{
+		Bucket: bucket,
+	}
+	input.Quota = int64(d.Get("quota").(int))
+
+	log.Printf("[DEBUG] OBS bucket %s, set quota: %d", bucket, input.Quota)
+	_, err := obsClient.SetBucketQuota(input)
+	if err != nil {
+		return fmt.Errorf("Error setting quota of OBS bucket %s: %s", bucket, err)
+	}
+	return nil
+}
+
+func resourceObsBucketTagsUpdate(obsClient *obs.ObsClient, d *schema.ResourceData, meta interface{}) error {
+	bucket := d.Get("bucket").(string)
+	tags := tagsFromMapOBS(resourceTags(d, meta))
+
+	var err error
+	if len(tags) == 0 {
+		log.Printf("[DEBUG] OBS bucket %s, delete tags", bucket)
+		_, err = obsClient.DeleteBucketTagging(bucket)
+	} else {
+		log.Printf("[DEBUG] OBS bucket %s, set tags: %#v", bucket, tags)
+		input := &obs.SetBucketTaggingInput{
+			Bucket: bucket,
+		}
+		input.Tags = tags
+		_, err = obsClient.SetBucketTagging(input)
+	}
+	if err != nil {
+		return fmt.Errorf("Error updating tags of OBS bucket %s: %s", bucket, err)
+	}
+	return nil
+}
+
+// deleteAllObsBucketObjects deletes every object version and delete marker of a bucket.
+func deleteAllObsBucketObjects(obsClient *obs.ObsClient, bucket string) error {
+	input := &obs.ListVersionsInput{
+		Bucket: bucket,
+	}
+	for {
+		resp, err := obsClient.ListVersions(input)
+		if err != nil {
+			return fmt.Errorf("Error listing objects of OBS bucket %s: %s", bucket, err)
+		}
+
+		for _, v := range resp.Versions {
+			if err := deleteObsObjectVersion(obsClient, bucket, v.Key, v.VersionId); err != nil {
+				return err
+			}
+		}
+		for _, v := range resp.DeleteMarkers {
+			if err := deleteObsObjectVersion(obsClient, bucket, v.Key, v.VersionId); err != nil {
+				return err
+			}
+		}
+
+		if !resp.IsTruncated {
+			return nil
+		}
+		input.KeyMarker = resp.NextKeyMarker
+		input.VersionIdMarker = resp.NextVersionIdMarker
+	}
+}
+
+func deleteObsObjectVersion(obsClient *obs.ObsClient, bucket, key, versionId string) error {
+	input := &obs.DeleteObjectInput{
+		Bucket: bucket,
+		Key:    key,
+	}
+	// Objects of buckets without versioning are listed with version "null".
+	if versionId != "null" {
+		input.VersionId = versionId
+	}
+
+	_, err := obsClient.DeleteObject(input)
+	if err != nil && !isObsNotFound(err) {
+		return fmt.Errorf("Error deleting object %s of OBS bucket %s: %s", key, bucket, err)
+	}
+	return nil
+}
+
+// isObsNotFound returns true if err is a 404 response of OBS.
+func isObsNotFound(err error) bool {
+	obsError, ok := err.(obs.ObsError)
+	return ok && obsError.StatusCode == 404
+}
+
+// tagsFromMapOBS returns the tags for the given map of data, sorted by key.
+func tagsFromMapOBS(m map[string]string) []obs.Tag {
+	tags := make([]obs.Tag, 0, len(m))
+	for k, v := range m {
+		tags = append(tags, obs.Tag{
+			Key:   k,
+			Value: v,
+		})
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Key < tags[j].Key })
+	return tags
+}
+
+// tagsToMapOBS turns the list of tags into a map.
+func tagsToMapOBS(tags []obs.Tag) map[string]string {
+	result := make(map[string]string, len(tags))
+	for _, t := range tags {
+		result[t.Key] = t.Value
+	}
+	return result
+}
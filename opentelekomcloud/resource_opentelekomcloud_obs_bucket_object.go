@@ -0,0 +1,252 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mitchellh/go-homedir"
+	"github.com/terraform-providers/terraform-provider-opentelekomcloud/opentelekomcloud/obs"
+)
+
+func resourceObsBucketObject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceObsBucketObjectPut,
+		Read:   resourceObsBucketObjectRead,
+		Update: resourceObsBucketObjectPut,
+		Delete: resourceObsBucketObjectDelete,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"source": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"content"},
+			},
+
+			"content": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"source"},
+			},
+
+			"content_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"acl": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "private",
+				ValidateFunc: validation.StringInSlice([]string{
+					"private", "public-read", "public-read-write",
+				}, false),
+			},
+
+			"storage_class": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"STANDARD", "WARM", "COLD",
+				}, false),
+			},
+
+			"encryption": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"etag": {
+				Type: schema.TypeString,
+				// The ETag of an object encrypted with KMS is not the MD5 of its content.
+				Optional: true,
+				Computed: true,
+			},
+
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceObsBucketObjectPut(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	obsClient, err := config.newObjectStorageClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	putInput := &obs.PutObjectInput{
+		Bucket:       bucket,
+		Key:          key,
+		ACL:          obs.AclType(d.Get("acl").(string)),
+		StorageClass: obs.StorageClassType(d.Get("storage_class").(string)),
+		ContentType:  d.Get("content_type").(string),
+		SseKms:       d.Get("encryption").(bool),
+	}
+	if putInput.SseKms {
+		putInput.KmsKeyId = d.Get("kms_key_id").(string)
+	}
+
+	if v, ok := d.GetOk("source"); ok {
+		source := v.(string)
+		path, err := homedir.Expand(source)
+		if err != nil {
+			return fmt.Errorf("Error expanding homedir in source (%s): %s", source, err)
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("Error opening OBS bucket object source (%s): %s", source, err)
+		}
+		defer file.Close()
+
+		info, err := file.Stat()
+		if err != nil {
+			return fmt.Errorf("Error reading OBS bucket object source (%s): %s", source, err)
+		}
+		putInput.Body = file
+		putInput.ContentLength = info.Size()
+	} else if v, ok := d.GetOk("content"); ok {
+		content := v.(string)
+		putInput.Body = strings.NewReader(content)
+		putInput.ContentLength = int64(len(content))
+	} else {
+		return fmt.Errorf("Must specify \"source\" or \"content\" field")
+	}
+
+	log.Printf("[DEBUG] Putting object %s into OBS bucket %s", key, bucket)
+	resp, err := obsClient.PutObject(putInput)
+	if err != nil {
+		return fmt.Errorf("Error putting object in OBS bucket (%s): %s", bucket, err)
+	}
+
+	d.Set("etag", strings.Trim(resp.ETag, `"`))
+	d.Set("version_id", resp.VersionId)
+	d.SetId(key)
+
+	return resourceObsBucketObjectRead(d, meta)
+}
+
+func resourceObsBucketObjectRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	obsClient, err := config.newObjectStorageClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	resp, err := obsClient.GetObjectMetadata(&obs.GetObjectMetadataInput{
+		Bucket: bucket,
+		Key:    key,
+	})
+	if err != nil {
+		if isObsNotFound(err) {
+			log.Printf("[WARN] OBS bucket object (%s) not found, removing from state", key)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading OBS bucket object %s: %s", key, err)
+	}
+	log.Printf("[DEBUG] Reading OBS bucket object meta: %#v", resp)
+
+	d.Set("content_type", resp.ContentType)
+	d.Set("storage_class", string(resp.StorageClass))
+	d.Set("encryption", resp.SseKms)
+	d.Set("kms_key_id", resp.KmsKeyId)
+	d.Set("size", resp.ContentLength)
+	d.Set("version_id", resp.VersionId)
+	d.Set("etag", strings.Trim(resp.ETag, `"`))
+
+	return nil
+}
+
+func resourceObsBucketObjectDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	obsClient, err := config.newObjectStorageClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+
+	if _, ok := d.GetOk("version_id"); !ok {
+		if err := deleteObsObjectVersion(obsClient, bucket, key, ""); err != nil {
+			return err
+		}
+		d.SetId("")
+		return nil
+	}
+
+	// Bucket is versioned, we need to delete all versions of the key
+	input := &obs.ListVersionsInput{
+		Bucket: bucket,
+		Prefix: key,
+	}
+	for {
+		resp, err := obsClient.ListVersions(input)
+		if err != nil {
+			return fmt.Errorf("Error listing versions of OBS bucket object %s: %s", key, err)
+		}
+
+		for _, v := range resp.Versions {
+			if v.Key != key {
+				continue
+			}
+			if err := deleteObsObjectVersion(obsClient, bucket, key, v.VersionId); err != nil {
+				return err
+			}
+		}
+		for _, v := range resp.DeleteMarkers {
+			if v.Key != key {
+				continue
+			}
+			if err := deleteObsObjectVersion(obsClient, bucket, key, v.VersionId); err != nil {
+				return err
+			}
+		}
+
+		if !resp.IsTruncated {
+			break
+		}
+		input.KeyMarker = resp.NextKeyMarker
+		input.VersionIdMarker = resp.NextVersionIdMarker
+	}
+
+	d.SetId("")
+	return nil
+}
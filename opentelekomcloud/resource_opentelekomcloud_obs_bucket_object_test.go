@@ -0,0 +1,161 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-opentelekomcloud/opentelekomcloud/obs"
+)
+
+func TestAccObsBucketObject_source(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "tf-acc-obs-obj-source")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	rInt := acctest.RandInt()
+	// first write some data to the tempfile just so it's not 0 bytes.
+	err = ioutil.WriteFile(tmpFile.Name(), []byte("{anything will do }"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckObsBucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketObjectConfigSource(rInt, tmpFile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketObjectExists("opentelekomcloud_obs_bucket_object.object"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_obs_bucket_object.object", "content_type", "binary/octet-stream"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_obs_bucket_object.object", "size", "19"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObsBucketObject_content(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "opentelekomcloud_obs_bucket_object.object"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckObsBucketObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketObjectConfigContent(rInt, "some_bucket_content", "STANDARD"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketObjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/plain"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class", "STANDARD"),
+				),
+			},
+			{
+				Config: testAccObsBucketObjectConfigContent(rInt, "updated_content", "WARM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketObjectExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_class", "WARM"),
+					resource.TestCheckResourceAttr(resourceName, "size", "15"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckObsBucketObjectDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	obsClient, err := config.newObjectStorageClient(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud OBS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_obs_bucket_object" {
+			continue
+		}
+
+		_, err := obsClient.GetObjectMetadata(&obs.GetObjectMetadataInput{
+			Bucket: rs.Primary.Attributes["bucket"],
+			Key:    rs.Primary.Attributes["key"],
+		})
+		if err == nil {
+			return fmt.Errorf("OBS object %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckObsBucketObjectExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No OBS object ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		obsClient, err := config.newObjectStorageClient(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud OBS client: %s", err)
+		}
+
+		_, err = obsClient.GetObjectMetadata(&obs.GetObjectMetadataInput{
+			Bucket: rs.Primary.Attributes["bucket"],
+			Key:    rs.Primary.Attributes["key"],
+		})
+		if err != nil {
+			return fmt.Errorf("OBS object error: %s", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccObsBucketObjectConfigSource(randInt int, source string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "object_bucket" {
+  bucket        = "tf-object-test-bucket-%d"
+  force_destroy = true
+}
+
+resource "opentelekomcloud_obs_bucket_object" "object" {
+  bucket       = "${opentelekomcloud_obs_bucket.object_bucket.bucket}"
+  key          = "test-key"
+  source       = "%s"
+  content_type = "binary/octet-stream"
+}
+`, randInt, source)
+}
+
+func testAccObsBucketObjectConfigContent(randInt int, content, storageClass string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "object_bucket" {
+  bucket        = "tf-object-test-bucket-%d"
+  force_destroy = true
+}
+
+resource "opentelekomcloud_obs_bucket_object" "object" {
+  bucket        = "${opentelekomcloud_obs_bucket.object_bucket.bucket}"
+  key           = "test-key"
+  content       = "%s"
+  content_type  = "text/plain"
+  storage_class = "%s"
+}
+`, randInt, content, storageClass)
+}
@@ -0,0 +1,128 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-opentelekomcloud/opentelekomcloud/obs"
+)
+
+func resourceObsBucketPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceObsBucketPolicyPut,
+		Read:   resourceObsBucketPolicyRead,
+		Update: resourceObsBucketPolicyPut,
+		Delete: resourceObsBucketPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceObsBucketPolicyImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// OBS policies name principals by ID ("domain/<domain id>:user/<user id>")
+			// and actions without a service prefix, so they are compared as plain JSON.
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+		},
+	}
+}
+
+func resourceObsBucketPolicyPut(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	obsClient, err := config.newObjectStorageClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	policy := d.Get("policy").(string)
+
+	log.Printf("[DEBUG] OBS bucket: %s, put policy: %s", bucket, policy)
+	params := &obs.SetBucketPolicyInput{
+		Bucket: bucket,
+		Policy: policy,
+	}
+
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+		if _, err := obsClient.SetBucketPolicy(params); err != nil {
+			// A policy referring to a newly created user may be rejected for a while.
+			if obsError, ok := err.(obs.ObsError); ok && obsError.Code == "MalformedPolicy" {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error putting OBS policy: %s", err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceObsBucketPolicyRead(d, meta)
+}
+
+func resourceObsBucketPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	obsClient, err := config.newObjectStorageClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud OBS client: %s", err)
+	}
+
+	log.Printf("[DEBUG] OBS bucket policy, read for bucket: %s", d.Id())
+	pol, err := obsClient.GetBucketPolicy(d.Id())
+	if err != nil {
+		if isObsNotFound(err) {
+			log.Printf("[WARN] Policy of OBS bucket (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading policy of OBS bucket %s: %s", d.Id(), err)
+	}
+
+	d.Set("bucket", d.Id())
+	if err := d.Set("policy", pol.Policy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceObsBucketPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	obsClient, err := config.newObjectStorageClient(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud OBS client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+
+	log.Printf("[DEBUG] OBS bucket: %s, delete policy", bucket)
+	_, err = obsClient.DeleteBucketPolicy(bucket)
+	if err != nil {
+		if isObsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting OBS policy: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceObsBucketPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("bucket", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,95 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccObsBucketPolicy_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-test-obs-bucket-%d", acctest.RandInt())
+	resourceName := "opentelekomcloud_obs_bucket_policy.policy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckObsBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketPolicyConfig(name, "GetObject"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketHasPolicy(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bucket", name),
+				),
+			},
+			{
+				Config: testAccObsBucketPolicyConfig(name, "PutObject"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketHasPolicy(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckObsBucketHasPolicy(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No OBS bucket ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		obsClient, err := config.newObjectStorageClient(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud OBS client: %s", err)
+		}
+
+		policy, err := obsClient.GetBucketPolicy(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("GetBucketPolicy error: %v", err)
+		}
+
+		if normalizeJson(policy.Policy) != normalizeJson(rs.Primary.Attributes["policy"]) {
+			return fmt.Errorf("Non-equivalent policy error:\n\nexpected: %s\n\n     got: %s\n",
+				rs.Primary.Attributes["policy"], policy.Policy)
+		}
+
+		return nil
+	}
+}
+
+func testAccObsBucketPolicyConfig(bucketName, action string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket = "%s"
+}
+
+resource "opentelekomcloud_obs_bucket_policy" "policy" {
+  bucket = "${opentelekomcloud_obs_bucket.bucket.bucket}"
+  policy = <<POLICY
+{
+  "Statement": [{
+    "Sid": "AddPerm",
+    "Effect": "Allow",
+    "Principal": {"ID": ["*"]},
+    "Action": ["%s"],
+    "Resource": ["%s/*"]
+  }]
+}
+POLICY
+}
+`, bucketName, action, bucketName)
+}
@@ -0,0 +1,239 @@
+package opentelekomcloud
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-opentelekomcloud/opentelekomcloud/obs"
+)
+
+func TestAccObsBucket_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "opentelekomcloud_obs_bucket.bucket"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckObsBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketConfig_basic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bucket", testAccObsBucketName(rInt)),
+					resource.TestCheckResourceAttr(resourceName, "storage_class", "STANDARD"),
+					resource.TestCheckResourceAttr(resourceName, "versioning", "false"),
+					resource.TestCheckResourceAttr(resourceName, "quota", "0"),
+					resource.TestCheckResourceAttr(resourceName, "region", OS_REGION_NAME),
+					resource.TestCheckResourceAttr(resourceName, "acl", "private"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+				),
+			},
+			{
+				Config: testAccObsBucketConfig_update(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObsBucketExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_class", "WARM"),
+					resource.TestCheckResourceAttr(resourceName, "acl", "public-read"),
+					resource.TestCheckResourceAttr(resourceName, "versioning", "true"),
+					resource.TestCheckResourceAttr(resourceName, "quota", "1073741824"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key", "value"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObsBucket_importBasic(t *testing.T) {
+	resourceName := "opentelekomcloud_obs_bucket.bucket"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckObsBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObsBucketConfig_basic(acctest.RandInt()),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+		},
+	})
+}
+
+func TestObsBucketCannedAcl(t *testing.T) {
+	cases := []struct {
+		name string
+		acl  string
+		want obs.AclType
+	}{
+		{
+			name: "private",
+			acl: `<AccessControlPolicy xmlns="http://obs.otc.t-systems.com/doc/2015-06-30/">
+  <Owner><ID>owner</ID></Owner>
+  <AccessControlList>
+    <Grant><Grantee><ID>owner</ID></Grantee><Permission>FULL_CONTROL</Permission></Grant>
+  </AccessControlList>
+</AccessControlPolicy>`,
+			want: obs.AclPrivate,
+		},
+		{
+			name: "public-read",
+			acl: `<AccessControlPolicy>
+  <Owner><ID>owner</ID></Owner>
+  <AccessControlList>
+    <Grant><Grantee><ID>owner</ID></Grantee><Permission>FULL_CONTROL</Permission></Grant>
+    <Grant><Grantee><Canned>Everyone</Canned></Grantee><Permission>READ</Permission></Grant>
+  </AccessControlList>
+</AccessControlPolicy>`,
+			want: obs.AclPublicRead,
+		},
+		{
+			name: "public-read-write delivered",
+			acl: `<AccessControlPolicy>
+  <Owner><ID>owner</ID></Owner>
+  <AccessControlList>
+    <Grant><Grantee><ID>owner</ID></Grantee><Permission>FULL_CONTROL</Permission></Grant>
+    <Grant><Grantee><Canned>Everyone</Canned></Grantee><Permission>WRITE</Permission><Delivered>true</Delivered></Grant>
+    <Grant><Grantee><Canned>Everyone</Canned></Grantee><Permission>READ</Permission><Delivered>true</Delivered></Grant>
+  </AccessControlList>
+</AccessControlPolicy>`,
+			want: obs.AclPublicReadWriteDelivered,
+		},
+		{
+			name: "log-delivery-write",
+			acl: `<AccessControlPolicy>
+  <Owner><ID>owner</ID></Owner>
+  <AccessControlList>
+    <Grant><Grantee><ID>owner</ID></Grantee><Permission>FULL_CONTROL</Permission></Grant>
+    <Grant><Grantee><URI>http://acs.amazonaws.com/groups/s3/LogDelivery</URI></Grantee><Permission>WRITE</Permission></Grant>
+    <Grant><Grantee><URI>http://acs.amazonaws.com/groups/s3/LogDelivery</URI></Grantee><Permission>READ_ACP</Permission></Grant>
+  </AccessControlList>
+</AccessControlPolicy>`,
+			want: obs.AclLogDeliveryWrite,
+		},
+		{
+			name: "grant to another user",
+			acl: `<AccessControlPolicy>
+  <Owner><ID>owner</ID></Owner>
+  <AccessControlList>
+    <Grant><Grantee><ID>owner</ID></Grantee><Permission>FULL_CONTROL</Permission></Grant>
+    <Grant><Grantee><ID>other</ID></Grantee><Permission>READ</Permission></Grant>
+  </AccessControlList>
+</AccessControlPolicy>`,
+			want: "",
+		},
+		{
+			name: "write only",
+			acl: `<AccessControlPolicy>
+  <Owner><ID>owner</ID></Owner>
+  <AccessControlList>
+    <Grant><Grantee><Canned>Everyone</Canned></Grantee><Permission>WRITE</Permission></Grant>
+  </AccessControlList>
+</AccessControlPolicy>`,
+			want: "",
+		},
+	}
+
+	for _, tc := range cases {
+		var policy obs.AccessControlPolicy
+		if err := xml.Unmarshal([]byte(tc.acl), &policy); err != nil {
+			t.Fatalf("%s: %s", tc.name, err)
+		}
+		if got := obsBucketCannedAcl(&policy); got != tc.want {
+			t.Errorf("%s: expected acl %q, got %q", tc.name, tc.want, got)
+		}
+	}
+}
+
+func testAccCheckObsBucketDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	obsClient, err := config.newObjectStorageClient(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud OBS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_obs_bucket" {
+			continue
+		}
+
+		_, err := obsClient.HeadBucket(rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("OBS bucket %s still exists", rs.Primary.ID)
+		}
+		if !isObsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckObsBucketExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		obsClient, err := config.newObjectStorageClient(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud OBS client: %s", err)
+		}
+
+		_, err = obsClient.HeadBucket(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("OBS bucket not found: %v", err)
+		}
+		return nil
+	}
+}
+
+func testAccObsBucketName(randInt int) string {
+	return fmt.Sprintf("tf-test-obs-bucket-%d", randInt)
+}
+
+func testAccObsBucketConfig_basic(randInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket = "tf-test-obs-bucket-%d"
+
+  tags {
+    foo = "bar"
+  }
+}
+`, randInt)
+}
+
+func testAccObsBucketConfig_update(randInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_obs_bucket" "bucket" {
+  bucket        = "tf-test-obs-bucket-%d"
+  storage_class = "WARM"
+  acl           = "public-read"
+  versioning    = true
+  quota         = 1073741824
+
+  tags {
+    foo = "bar"
+    key = "value"
+  }
+}
+`, randInt)
+}
@@ -204,6 +204,9 @@ func resourceRdsInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -347,6 +350,12 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 			instance.ID, err)
 	}
 
+	if len(resourceTags(d, meta)) > 0 {
+		if err := setResourceTags(d, meta, rdsInstanceTagManager(client)); err != nil {
+			return fmt.Errorf("Error setting tags of instance (%s): %s ", instance.ID, err)
+		}
+	}
+
 	if instance.ID != "" {
 		return resourceInstanceRead(d, meta)
 	}
@@ -431,6 +440,10 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("updated", instance.Updated)
 	d.Set("created", instance.Created)
+
+	if err := readResourceTags(d, meta, rdsInstanceTagManager(client)); err != nil {
+		return fmt.Errorf("Error retrieving tags of Rds instance (%s): %s", d.Id(), err)
+	}
 	return nil
 }
 
@@ -594,7 +607,25 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] Successfully updated instance %s policy: %+v", id, updatepolicyOpts)
 	}
 
+	if resourceTagsChanged(d, meta) {
+		if err := setResourceTags(d, meta, rdsInstanceTagManager(client)); err != nil {
+			return fmt.Errorf("Error updating tags of instance (%s): %s ", id, err)
+		}
+	}
+
 	log.Printf("[DEBUG] Successfully updated instance %s", id)
 	d.SetId(id)
 	return resourceInstanceRead(d, meta)
 }
+
+// rdsInstanceTagManager returns the tagManager of the RDS instances. Their
+// tags live under /v1/{project_id}/rds rather than the /rds/v1/{project_id}
+// base of the other RDS v1 calls.
+func rdsInstanceTagManager(client *golangsdk.ServiceClient) *tagManager {
+	tagClient := *client
+	tagClient.ResourceBase = strings.Replace(client.ResourceBase, "/rds/v1/", "/v1/", 1)
+	return &tagManager{
+		client:  &tagClient,
+		srvType: "rds",
+	}
+}
@@ -27,6 +27,8 @@ func TestAccRDSV1Instance_basic(t *testing.T) {
 						"opentelekomcloud_rds_instance_v1.instance", "region", "eu-de"),
 					resource.TestCheckResourceAttr(
 						"opentelekomcloud_rds_instance_v1.instance", "availabilityzone", "eu-de-01"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_rds_instance_v1.instance", "tags.foo", "bar"),
 				),
 				ExpectNonEmptyPlan: true,
 			},
@@ -130,5 +132,8 @@ resource "opentelekomcloud_rds_instance_v1" "instance" {
     enable = true
     replicationmode = "async"
   }
+  tags {
+    foo = "bar"
+  }
   depends_on = ["opentelekomcloud_compute_secgroup_v2.secgrp_rds"]
 }`, OS_VPC_ID, OS_NETWORK_ID)
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/rts/v1/stackevents"
 	"github.com/huaweicloud/golangsdk/openstack/rts/v1/stacks"
 	"github.com/huaweicloud/golangsdk/openstack/rts/v1/stacktemplates"
 )
@@ -113,7 +114,10 @@ func resourceRTSStackV1() *schema.Resource {
 
 func resourceTemplateOptsV1(d *schema.ResourceData) *stacks.Template {
 	var template = new(stacks.Template)
-	if _, ok := d.GetOk("template_body"); ok {
+	// template_body is read back from the stack, so it holds the previous
+	// template when only template_url was changed.
+	urlChanged := d.HasChange("template_url") && !d.HasChange("template_body")
+	if _, ok := d.GetOk("template_body"); ok && !urlChanged {
 		rawTemplate := d.Get("template_body").(string)
 		template.Bin = []byte(rawTemplate)
 	}
@@ -123,12 +127,7 @@ func resourceTemplateOptsV1(d *schema.ResourceData) *stacks.Template {
 
 	}
 	if _, ok := d.GetOk("files"); ok {
-		rawFiles := make(map[string]string)
-		for key, val := range d.Get("files").(map[string]interface{}) {
-			rawFiles[key] = val.(string)
-		}
-		template.Files = rawFiles
-
+		template.Files = resourceFilesV1(d)
 	}
 	return template
 }
@@ -139,6 +138,14 @@ func resourceEnvironmentV1(d *schema.ResourceData) *stacks.Environment {
 	environment.Bin = []byte(rawTemplate)
 	return environment
 }
+func resourceFilesV1(d *schema.ResourceData) map[string]string {
+	files := make(map[string]string)
+	for key, val := range d.Get("files").(map[string]interface{}) {
+		files[key] = val.(string)
+	}
+	return files
+}
+
 func resourceParametersV1(d *schema.ResourceData) map[string]string {
 	m := make(map[string]string)
 	for key, val := range d.Get("parameters").(map[string]interface{}) {
@@ -147,6 +154,34 @@ func resourceParametersV1(d *schema.ResourceData) map[string]string {
 
 	return m
 }
+
+// resourceRTSStackV1ValidateTemplate validates the template of the stack with
+// the RTS service, so that an invalid template fails before the stack is
+// created or updated rather than with a rolled back stack.
+func resourceRTSStackV1ValidateTemplate(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	template := resourceTemplateOptsV1(d)
+	_, err := validateRTSTemplateV1(client, stacktemplates.ValidateOpts{
+		Template:    string(template.Bin),
+		TemplateURL: template.URL,
+		Environment: d.Get("environment").(string),
+		Files:       template.Files,
+	})
+	return err
+}
+
+// resourceRTSStackV1UpdateOpts returns the whole stack definition, as an
+// update resets the options which are left out to their defaults.
+func resourceRTSStackV1UpdateOpts(d *schema.ResourceData) stacks.UpdateOpts {
+	rollback := d.Get("disable_rollback").(bool)
+	return stacks.UpdateOpts{
+		TemplateOpts:    resourceTemplateOptsV1(d),
+		EnvironmentOpts: resourceEnvironmentV1(d),
+		Parameters:      resourceParametersV1(d),
+		Timeout:         d.Get("timeout_mins").(int),
+		DisableRollback: &rollback,
+	}
+}
+
 func resourceRTSStackV1Create(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -157,6 +192,10 @@ func resourceRTSStackV1Create(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating RTS client: %s", err)
 	}
 
+	if err := resourceRTSStackV1ValidateTemplate(orchestrationClient, d); err != nil {
+		return err
+	}
+
 	rollback := d.Get("disable_rollback").(bool)
 	createOpts := stacks.CreateOpts{
 		Name:            stackName,
@@ -176,7 +215,7 @@ func resourceRTSStackV1Create(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[INFO] Stack %s created successfully", stackName)
 
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"CREATE_IN_PROGRESS"},
+		Pending:    []string{"CREATE_IN_PROGRESS", "ROLLBACK_IN_PROGRESS"},
 		Target:     []string{"CREATE_COMPLETE"},
 		Refresh:    waitForRTSStackActive(orchestrationClient, stackName),
 		Timeout:    d.Timeout(schema.TimeoutCreate),
@@ -266,33 +305,29 @@ func resourceRTSStackV1Update(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating RTS Client: %s", err)
 	}
 
-	var updateOpts stacks.UpdateOpts
-
-	updateOpts.TemplateOpts = resourceTemplateOptsV1(d)
-	updateOpts.EnvironmentOpts = resourceEnvironmentV1(d)
-	updateOpts.Parameters = resourceParametersV1(d)
-
-	if d.HasChange("timeout_mins") {
-
-		updateOpts.Timeout = d.Get("timeout_mins").(int)
+	if d.HasChange("template_body") || d.HasChange("template_url") || d.HasChange("files") || d.HasChange("environment") {
+		if err := resourceRTSStackV1ValidateTemplate(orchestrationClient, d); err != nil {
+			return err
+		}
 	}
-	if d.HasChange("disable_rollback") {
 
-		rollback := d.Get("disable_rollback").(bool)
-		updateOpts.DisableRollback = &rollback
+	stackName := d.Get("name").(string)
+	stack, err := stacks.Get(orchestrationClient, stackName).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving Stack: %s", err)
 	}
 
-	err = stacks.Update(orchestrationClient, d.Get("name").(string), d.Id(), updateOpts).ExtractErr()
+	err = stacks.Update(orchestrationClient, stackName, d.Id(), resourceRTSStackV1UpdateOpts(d)).ExtractErr()
 	if err != nil {
 		return fmt.Errorf("Error updating Stack: %s", err)
 	}
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"UPDATE_IN_PROGRESS",
-			"CREATE_COMPLETE",
+		Pending: []string{"UPDATE_PENDING",
+			"UPDATE_IN_PROGRESS",
 			"ROLLBACK_IN_PROGRESS"},
 		Target:     []string{"UPDATE_COMPLETE"},
-		Refresh:    waitForRTSStackUpdate(orchestrationClient, d.Get("name").(string)),
-		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Refresh:    waitForRTSStackUpdate(orchestrationClient, stackName, stack.UpdatedTime),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
 		Delay:      5 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
@@ -300,11 +335,20 @@ func resourceRTSStackV1Update(d *schema.ResourceData, meta interface{}) error {
 	_, stateErr := stateConf.WaitForState()
 
 	if stateErr != nil {
+		// Keep the previous arguments in the state, so that the next apply
+		// retries the update.
+		d.Partial(true)
+		if failed, ok := stateErr.(*rtsStackFailedError); ok {
+			d.Set("status", failed.stack.Status)
+			d.Set("status_reason", failed.stack.StatusReason)
+			d.SetPartial("status")
+			d.SetPartial("status_reason")
+		}
 		return fmt.Errorf(
 			"Error waiting for updating stack: %s", stateErr)
 	}
 
-	log.Printf("[INFO] Successfully updated stack %s", d.Get("name").(string))
+	log.Printf("[INFO] Successfully updated stack %s", stackName)
 
 	return resourceRTSStackV1Read(d, meta)
 }
@@ -354,8 +398,8 @@ func waitForRTSStackActive(orchestrationClient *golangsdk.ServiceClient, stackNa
 			return n, n.Status, nil
 		}
 
-		if n.Status == "CREATE_FAILED" {
-			return nil, "", fmt.Errorf("%s: %s", n.Status, n.StatusReason)
+		if n.Status == "CREATE_FAILED" || n.Status == "ROLLBACK_COMPLETE" || n.Status == "ROLLBACK_FAILED" {
+			return nil, "", newRTSStackFailedError(orchestrationClient, n, n.CreationTime)
 		}
 		return n, n.Status, nil
 	}
@@ -400,21 +444,59 @@ func waitForRTSStackDelete(orchestrationClient *golangsdk.ServiceClient, stackNa
 	}
 }
 
-func waitForRTSStackUpdate(orchestrationClient *golangsdk.ServiceClient, stackName string) resource.StateRefreshFunc {
+func waitForRTSStackUpdate(orchestrationClient *golangsdk.ServiceClient, stackName string, previousUpdate time.Time) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		n, err := stacks.Get(orchestrationClient, stackName).Extract()
 		if err != nil {
 			return nil, "", err
 		}
 
-		if n.Status == "UPDATE_IN_PROGRESS" {
-			return n, "UPDATE_IN_PROGRESS", nil
+		// Until the update has started, the stack reports the status of the
+		// previous operation.
+		if n.Status != "UPDATE_IN_PROGRESS" && !n.UpdatedTime.After(previousUpdate) {
+			return n, "UPDATE_PENDING", nil
 		}
-		if n.Status == "ROLLBACK_COMPLETE" || n.Status == "ROLLBACK_FAILED" || n.Status == "UPDATE_FAILED" {
 
-			return nil, "", fmt.Errorf("%s: %s", n.Status, n.StatusReason)
+		if n.Status == "ROLLBACK_COMPLETE" || n.Status == "ROLLBACK_FAILED" || n.Status == "UPDATE_FAILED" {
+			return nil, "", newRTSStackFailedError(orchestrationClient, n, n.UpdatedTime)
 		}
 
 		return n, n.Status, nil
 	}
 }
+
+// rtsStackFailedError is returned when a stack operation fails. It reports
+// the status reason of the stack and the resources which failed.
+type rtsStackFailedError struct {
+	stack  *stacks.RetrievedStack
+	events []stackevents.Event
+}
+
+func (e *rtsStackFailedError) Error() string {
+	msg := fmt.Sprintf("%s: %s", e.stack.Status, e.stack.StatusReason)
+	for _, event := range e.events {
+		msg += fmt.Sprintf("\n  %s %s: %s", event.ResourceName, event.ResourceStatus, event.ResourceStatusReason)
+	}
+	return msg
+}
+
+// newRTSStackFailedError returns the error of a failed stack operation which
+// started at the given time.
+func newRTSStackFailedError(orchestrationClient *golangsdk.ServiceClient, stack *stacks.RetrievedStack, started time.Time) error {
+	failed := &rtsStackFailedError{stack: stack}
+
+	events, err := stackevents.List(orchestrationClient, stack.Name, stack.ID, stackevents.ListOpts{
+		ResourceStatus: "FAILED",
+	})
+	if err != nil {
+		log.Printf("[WARN] Error retrieving events of stack %s: %s", stack.Name, err)
+		return failed
+	}
+	for _, event := range events {
+		if !event.Time.Before(started) {
+			failed.events = append(failed.events, event)
+		}
+	}
+
+	return failed
+}
@@ -2,11 +2,13 @@ package opentelekomcloud
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 
+	"github.com/huaweicloud/golangsdk/openstack/rts/v1/stackevents"
 	"github.com/huaweicloud/golangsdk/openstack/rts/v1/stacks"
 )
 
@@ -44,10 +46,45 @@ func TestAccOTCRTSStackV1_basic(t *testing.T) {
 						"opentelekomcloud_rts_stack_v1.stack_1", "status", "UPDATE_COMPLETE"),
 				),
 			},
+			resource.TestStep{
+				Config: testAccRTSStackV1_updateTemplate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOTCRTSStackV1Exists("opentelekomcloud_rts_stack_v1.stack_1", &stacks),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_rts_stack_v1.stack_1", "disable_rollback", "false"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_rts_stack_v1.stack_1", "timeout_mins", "50"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_rts_stack_v1.stack_1", "status", "UPDATE_COMPLETE"),
+				),
+			},
 		},
 	})
 }
 
+func TestRTSStackFailedError(t *testing.T) {
+	err := &rtsStackFailedError{
+		stack: &stacks.RetrievedStack{
+			Name:         "terraform_provider_stack",
+			Status:       "UPDATE_FAILED",
+			StatusReason: "Resource UPDATE failed: Quota exceeded",
+		},
+		events: []stackevents.Event{
+			{
+				ResourceName:         "server",
+				ResourceStatus:       "UPDATE_FAILED",
+				ResourceStatusReason: "Quota exceeded for instances",
+			},
+		},
+	}
+
+	expected := "UPDATE_FAILED: Resource UPDATE failed: Quota exceeded\n" +
+		"  server UPDATE_FAILED: Quota exceeded for instances"
+	if err.Error() != expected {
+		t.Fatalf("Expected:\n%s\ngot:\n%s", expected, err.Error())
+	}
+}
+
 func TestAccOTCRTSStackV1_timeout(t *testing.T) {
 	var stacks stacks.RetrievedStack
 
@@ -66,6 +103,20 @@ func TestAccOTCRTSStackV1_timeout(t *testing.T) {
 	})
 }
 
+func TestAccOTCRTSStackV1_invalidTemplate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOTCRTSStackV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccRTSStackV1_invalidTemplate,
+				ExpectError: regexp.MustCompile("Invalid RTS template"),
+			},
+		},
+	})
+}
+
 func testAccCheckOTCRTSStackV1Destroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	orchestrationClient, err := config.orchestrationV1Client(OS_REGION_NAME)
@@ -197,6 +248,37 @@ JSON
 
 }
 `
+const testAccRTSStackV1_updateTemplate = `
+resource "opentelekomcloud_rts_stack_v1" "stack_1" {
+  name = "terraform_provider_stack"
+  disable_rollback= false
+  timeout_mins=50
+  template_body = <<JSON
+          {
+    "outputs": {
+      "str1": {
+        "description": "The description of the nat server.",
+        "value": {
+          "get_resource": "random"
+        }
+      }
+    },
+    "heat_template_version": "2013-05-23",
+    "description": "A HOT template that create a single server and boot from volume.",
+    "resources": {
+      "random": {
+        "type": "OS::Heat::RandomString",
+        "properties": {
+          "length": 8
+        }
+      }
+    }
+  }
+JSON
+
+}
+`
+
 const testAccRTSStackV1_timeout = `
 resource "opentelekomcloud_rts_stack_v1" "stack_1" {
   name = "terraform_provider_stack"
@@ -239,3 +321,20 @@ JSON
   }
 }
 `
+
+const testAccRTSStackV1_invalidTemplate = `
+resource "opentelekomcloud_rts_stack_v1" "stack_1" {
+  name = "terraform_provider_stack"
+
+  template_body = <<JSON
+  {
+    "heat_template_version": "2013-05-23",
+    "resources": {
+      "random": {
+        "type": "OS::Heat::NoSuchResourceType"
+      }
+    }
+  }
+JSON
+}
+`
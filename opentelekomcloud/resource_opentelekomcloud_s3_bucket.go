@@ -269,13 +269,122 @@ func resourceS3Bucket() *schema.Resource {
 				},
 			},
 
+			"server_side_encryption_configuration": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"apply_server_side_encryption_by_default": {
+										Type:     schema.TypeList,
+										MaxItems: 1,
+										Required: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"kms_master_key_id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"sse_algorithm": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Default:  s3.ServerSideEncryptionAwsKms,
+													ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+														return ValidateStringList(v, k, []string{s3.ServerSideEncryptionAwsKms})
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"replication_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"rules": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Set:      rulesHash,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateS3BucketReplicationRuleId,
+									},
+									"destination": {
+										Type:     schema.TypeSet,
+										MaxItems: 1,
+										MinItems: 1,
+										Required: true,
+										Set:      destinationHash,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"storage_class": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+														return ValidateStringList(v, k, []string{
+															s3.StorageClassStandard,
+															s3.StorageClassStandardIa,
+															s3.TransitionStorageClassGlacier,
+														})
+													},
+												},
+											},
+										},
+									},
+									"prefix": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+											return ValidateStringList(v, k, []string{
+												s3.ReplicationRuleStatusEnabled,
+												s3.ReplicationRuleStatusDisabled,
+											})
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"force_destroy": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -353,7 +462,7 @@ func resourceS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenTelekomCloud s3 client: %s", err)
 	}
 
-	if err := setTagsS3(s3conn, d); err != nil {
+	if err := setTagsS3(s3conn, d, meta); err != nil {
 		return fmt.Errorf("%q: %s", d.Get("bucket").(string), err)
 	}
 	if d.HasChange("policy") {
@@ -368,6 +477,12 @@ func resourceS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("server_side_encryption_configuration") {
+		if err := resourceS3BucketServerSideEncryptionConfigurationUpdate(s3conn, d); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("website") {
 		if err := resourceS3BucketWebsiteUpdate(s3conn, d); err != nil {
 			return err
@@ -379,6 +494,12 @@ func resourceS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 	}
+	// Replication requires versioning to be enabled first
+	if d.HasChange("replication_configuration") {
+		if err := resourceS3BucketReplicationConfigurationUpdate(s3conn, d); err != nil {
+			return err
+		}
+	}
 	if d.HasChange("acl") {
 		if err := resourceS3BucketAclUpdate(s3conn, d); err != nil {
 			return err
@@ -581,6 +702,42 @@ func resourceS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// Read the server side encryption configuration
+	encryptionResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		return getBucketEncryption(s3conn, aws.String(d.Id()))
+	})
+	if err != nil {
+		if awsError, ok := err.(awserr.RequestFailure); ok && awsError.StatusCode() != 404 {
+			return fmt.Errorf("error getting S3 Bucket encryption: %s", err)
+		}
+	}
+	encryption, _ := encryptionResponse.(*serverSideEncryptionConfiguration)
+	log.Printf("[DEBUG] S3 Bucket: %s, encryption: %v", d.Id(), encryption)
+	if err := d.Set("server_side_encryption_configuration", flattenS3ServerSideEncryptionConfiguration(encryption)); err != nil {
+		return fmt.Errorf("error setting server_side_encryption_configuration: %s", err)
+	}
+
+	// Read the replication configuration
+	replicationResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		return s3conn.GetBucketReplication(&s3.GetBucketReplicationInput{
+			Bucket: aws.String(d.Id()),
+		})
+	})
+	if err != nil {
+		if awsError, ok := err.(awserr.RequestFailure); ok && awsError.StatusCode() != 404 {
+			return fmt.Errorf("error getting S3 Bucket replication: %s", err)
+		}
+	}
+	replication, _ := replicationResponse.(*s3.GetBucketReplicationOutput)
+	log.Printf("[DEBUG] S3 Bucket: %s, read replication configuration: %v", d.Id(), replication)
+	var replicationConfiguration *s3.ReplicationConfiguration
+	if replication != nil {
+		replicationConfiguration = replication.ReplicationConfiguration
+	}
+	if err := d.Set("replication_configuration", flattenS3ReplicationConfiguration(replicationConfiguration)); err != nil {
+		return fmt.Errorf("error setting replication_configuration: %s", err)
+	}
+
 	// Read the logging configuration
 	loggingResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
 		return s3conn.GetBucketLogging(&s3.GetBucketLoggingInput{
@@ -781,7 +938,7 @@ func resourceS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	if err := d.Set("tags", tagsToMapS3(tagSet)); err != nil {
+	if err := setStateTags(d, meta, tagsToMapS3(tagSet)); err != nil {
 		return err
 	}
 
@@ -1329,6 +1486,209 @@ func resourceAwsS3BucketLifecycleUpdate(s3conn *s3.S3, d *schema.ResourceData) e
 	return nil
 }
 
+func resourceS3BucketServerSideEncryptionConfigurationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	encryptionConfiguration := d.Get("server_side_encryption_configuration").([]interface{})
+	if len(encryptionConfiguration) == 0 {
+		log.Printf("[DEBUG] Delete server side encryption configuration: %#v", encryptionConfiguration)
+		_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+			return nil, deleteBucketEncryption(s3conn, aws.String(bucket))
+		})
+		if err != nil {
+			return fmt.Errorf("error removing S3 bucket server side encryption: %s", err)
+		}
+		return nil
+	}
+
+	c := encryptionConfiguration[0].(map[string]interface{})
+	rc := &serverSideEncryptionConfiguration{}
+
+	rcRules := c["rule"].([]interface{})
+	var rules []*serverSideEncryptionRule
+	for _, v := range rcRules {
+		rr := v.(map[string]interface{})
+		rrDefault := rr["apply_server_side_encryption_by_default"].([]interface{})
+		sseAlgorithm := rrDefault[0].(map[string]interface{})["sse_algorithm"].(string)
+		kmsMasterKeyId := rrDefault[0].(map[string]interface{})["kms_master_key_id"].(string)
+		rcDefaultRule := &serverSideEncryptionByDefault{
+			SSEAlgorithm:   aws.String(sseAlgorithm),
+			KMSMasterKeyID: aws.String(kmsMasterKeyId),
+		}
+		rules = append(rules, &serverSideEncryptionRule{
+			ApplyServerSideEncryptionByDefault: rcDefaultRule,
+		})
+	}
+	rc.Rules = rules
+
+	log.Printf("[DEBUG] S3 put bucket encryption: %#v", rc)
+	_, err := retryOnAwsCodes([]string{"NoSuchBucket", "OperationAborted"}, func() (interface{}, error) {
+		return nil, putBucketEncryption(s3conn, aws.String(bucket), rc)
+	})
+	if err != nil {
+		return fmt.Errorf("error putting S3 server side encryption configuration: %s", err)
+	}
+
+	return nil
+}
+
+func resourceS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+	bucket := d.Get("bucket").(string)
+	replicationConfiguration := d.Get("replication_configuration").([]interface{})
+
+	if len(replicationConfiguration) == 0 {
+		i := &s3.DeleteBucketReplicationInput{
+			Bucket: aws.String(bucket),
+		}
+
+		_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+			return s3conn.DeleteBucketReplication(i)
+		})
+		if err != nil {
+			return fmt.Errorf("Error removing S3 bucket replication: %s", err)
+		}
+		return nil
+	}
+
+	hasVersioning := false
+	// Validate that bucket versioning is enabled
+	if versioning, ok := d.GetOk("versioning"); ok {
+		v := versioning.([]interface{})
+
+		if v[0].(map[string]interface{})["enabled"].(bool) {
+			hasVersioning = true
+		}
+	}
+
+	if !hasVersioning {
+		return fmt.Errorf("versioning must be enabled to allow S3 bucket replication")
+	}
+
+	c := replicationConfiguration[0].(map[string]interface{})
+
+	rc := &s3.ReplicationConfiguration{}
+	if val, ok := c["role"]; ok {
+		rc.Role = aws.String(val.(string))
+	}
+
+	rcRules := c["rules"].(*schema.Set).List()
+	rules := []*s3.ReplicationRule{}
+	for _, v := range rcRules {
+		rr := v.(map[string]interface{})
+		rcRule := &s3.ReplicationRule{
+			Prefix: aws.String(rr["prefix"].(string)),
+			Status: aws.String(rr["status"].(string)),
+		}
+
+		if rrid, ok := rr["id"]; ok && rrid.(string) != "" {
+			rcRule.ID = aws.String(rrid.(string))
+		}
+
+		ruleDestination := &s3.Destination{}
+		if destination, ok := rr["destination"].(*schema.Set); ok && destination.Len() > 0 {
+			bd := destination.List()[0].(map[string]interface{})
+			ruleDestination.Bucket = aws.String(bd["bucket"].(string))
+
+			if storageClass, ok := bd["storage_class"]; ok && storageClass != "" {
+				ruleDestination.StorageClass = aws.String(storageClass.(string))
+			}
+		}
+		rcRule.Destination = ruleDestination
+		rules = append(rules, rcRule)
+	}
+
+	rc.Rules = rules
+	i := &s3.PutBucketReplicationInput{
+		Bucket:                   aws.String(bucket),
+		ReplicationConfiguration: rc,
+	}
+	log.Printf("[DEBUG] S3 put bucket replication configuration: %#v", i)
+
+	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		return s3conn.PutBucketReplication(i)
+	})
+	if err != nil {
+		return fmt.Errorf("Error putting S3 replication configuration: %s", err)
+	}
+
+	return nil
+}
+
+func flattenS3ServerSideEncryptionConfiguration(c *serverSideEncryptionConfiguration) []map[string]interface{} {
+	var encryptionConfiguration []map[string]interface{}
+	if c == nil {
+		return encryptionConfiguration
+	}
+
+	rules := make([]interface{}, 0, len(c.Rules))
+	for _, v := range c.Rules {
+		if v.ApplyServerSideEncryptionByDefault == nil {
+			continue
+		}
+		d := make(map[string]interface{})
+		if v.ApplyServerSideEncryptionByDefault.KMSMasterKeyID != nil {
+			d["kms_master_key_id"] = *v.ApplyServerSideEncryptionByDefault.KMSMasterKeyID
+		}
+		if v.ApplyServerSideEncryptionByDefault.SSEAlgorithm != nil {
+			d["sse_algorithm"] = *v.ApplyServerSideEncryptionByDefault.SSEAlgorithm
+		}
+		rules = append(rules, map[string]interface{}{
+			"apply_server_side_encryption_by_default": []interface{}{d},
+		})
+	}
+	if len(rules) == 0 {
+		return encryptionConfiguration
+	}
+
+	encryptionConfiguration = append(encryptionConfiguration, map[string]interface{}{
+		"rule": rules,
+	})
+	return encryptionConfiguration
+}
+
+func flattenS3ReplicationConfiguration(r *s3.ReplicationConfiguration) []map[string]interface{} {
+	replication_configuration := make([]map[string]interface{}, 0, 1)
+	if r == nil {
+		return replication_configuration
+	}
+
+	m := make(map[string]interface{})
+
+	if r.Role != nil && *r.Role != "" {
+		m["role"] = *r.Role
+	}
+
+	rules := make([]interface{}, 0, len(r.Rules))
+	for _, v := range r.Rules {
+		t := make(map[string]interface{})
+		if v.Destination != nil {
+			rd := make(map[string]interface{})
+			if v.Destination.Bucket != nil {
+				rd["bucket"] = *v.Destination.Bucket
+			}
+			if v.Destination.StorageClass != nil {
+				rd["storage_class"] = *v.Destination.StorageClass
+			}
+			t["destination"] = schema.NewSet(destinationHash, []interface{}{rd})
+		}
+
+		if v.ID != nil {
+			t["id"] = *v.ID
+		}
+		if v.Prefix != nil {
+			t["prefix"] = *v.Prefix
+		}
+		if v.Status != nil {
+			t["status"] = *v.Status
+		}
+		rules = append(rules, t)
+	}
+	m["rules"] = schema.NewSet(rulesHash, rules)
+
+	replication_configuration = append(replication_configuration, m)
+
+	return replication_configuration
+}
+
 func normalizeRoutingRules(w []*s3.RoutingRule) (string, error) {
 	withNulls, err := json.Marshal(w)
 	if err != nil {
@@ -0,0 +1,242 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceS3BucketNotification() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceS3BucketNotificationPut,
+		Read:   resourceS3BucketNotificationRead,
+		Update: resourceS3BucketNotificationPut,
+		Delete: resourceS3BucketNotificationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"topic": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"filter_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"filter_suffix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"topic_arn": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"events": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceS3BucketNotificationPut(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	s3conn, err := config.computeS3conn(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud s3 client: %s", err)
+	}
+	bucket := d.Get("bucket").(string)
+
+	// TopicNotifications
+	topicNotifications := d.Get("topic").([]interface{})
+	topicConfigs := make([]*s3.TopicConfiguration, 0, len(topicNotifications))
+	for i, c := range topicNotifications {
+		tc := &s3.TopicConfiguration{}
+
+		c := c.(map[string]interface{})
+
+		// Id
+		if val, ok := c["id"].(string); ok && val != "" {
+			tc.Id = aws.String(val)
+		} else {
+			tc.Id = aws.String(resource.PrefixedUniqueId("tf-s3-topic-"))
+		}
+
+		// TopicArn
+		if val, ok := c["topic_arn"].(string); ok {
+			tc.TopicArn = aws.String(val)
+		}
+
+		// Events
+		events := d.Get(fmt.Sprintf("topic.%d.events", i)).(*schema.Set).List()
+		tc.Events = make([]*string, 0, len(events))
+		for _, e := range events {
+			tc.Events = append(tc.Events, aws.String(e.(string)))
+		}
+
+		// Filter
+		filterRules := make([]*s3.FilterRule, 0, 2)
+		if val, ok := c["filter_prefix"].(string); ok && val != "" {
+			filterRule := &s3.FilterRule{
+				Name:  aws.String("prefix"),
+				Value: aws.String(val),
+			}
+			filterRules = append(filterRules, filterRule)
+		}
+		if val, ok := c["filter_suffix"].(string); ok && val != "" {
+			filterRule := &s3.FilterRule{
+				Name:  aws.String("suffix"),
+				Value: aws.String(val),
+			}
+			filterRules = append(filterRules, filterRule)
+		}
+		if len(filterRules) > 0 {
+			tc.Filter = &s3.NotificationConfigurationFilter{
+				Key: &s3.KeyFilter{
+					FilterRules: filterRules,
+				},
+			}
+		}
+		topicConfigs = append(topicConfigs, tc)
+	}
+
+	notificationConfiguration := &s3.NotificationConfiguration{}
+	if len(topicConfigs) > 0 {
+		notificationConfiguration.TopicConfigurations = topicConfigs
+	}
+	i := &s3.PutBucketNotificationConfigurationInput{
+		Bucket:                    aws.String(bucket),
+		NotificationConfiguration: notificationConfiguration,
+	}
+
+	log.Printf("[DEBUG] S3 bucket: %s, Putting notification: %v", bucket, i)
+	_, err = retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		return s3conn.PutBucketNotificationConfiguration(i)
+	})
+	if err != nil {
+		return fmt.Errorf("Error putting S3 notification configuration: %s", err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceS3BucketNotificationRead(d, meta)
+}
+
+func resourceS3BucketNotificationDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	s3conn, err := config.computeS3conn(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud s3 client: %s", err)
+	}
+
+	i := &s3.PutBucketNotificationConfigurationInput{
+		Bucket:                    aws.String(d.Id()),
+		NotificationConfiguration: &s3.NotificationConfiguration{},
+	}
+
+	log.Printf("[DEBUG] S3 bucket: %s, Deleting notification: %v", d.Id(), i)
+	_, err = s3conn.PutBucketNotificationConfiguration(i)
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoSuchBucket" {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error deleting S3 notification configuration: %s", err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceS3BucketNotificationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	s3conn, err := config.computeS3conn(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud s3 client: %s", err)
+	}
+
+	var notificationConfigs *s3.NotificationConfiguration
+	notificationConfigs, err = s3conn.GetBucketNotificationConfiguration(&s3.GetBucketNotificationConfigurationRequest{
+		Bucket: aws.String(d.Id()),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoSuchBucket" {
+			log.Printf("[WARN] S3 Bucket (%s) not found, removing notification from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	log.Printf("[DEBUG] S3 Bucket: %s, get notification: %v", d.Id(), notificationConfigs)
+
+	d.Set("bucket", d.Id())
+
+	// Topic Notification
+	if err := d.Set("topic", flattenTopicConfigurations(notificationConfigs.TopicConfigurations)); err != nil {
+		return fmt.Errorf("error reading S3 bucket \"%s\" topic notification: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenNotificationConfigurationFilter(filter *s3.NotificationConfigurationFilter) map[string]interface{} {
+	filterRules := map[string]interface{}{}
+	if filter.Key == nil || filter.Key.FilterRules == nil {
+		return filterRules
+	}
+
+	for _, f := range filter.Key.FilterRules {
+		if strings.ToLower(*f.Name) == "prefix" {
+			filterRules["filter_prefix"] = *f.Value
+		}
+		if strings.ToLower(*f.Name) == "suffix" {
+			filterRules["filter_suffix"] = *f.Value
+		}
+	}
+	return filterRules
+}
+
+func flattenTopicConfigurations(configs []*s3.TopicConfiguration) []map[string]interface{} {
+	topicNotifications := make([]map[string]interface{}, 0, len(configs))
+	for _, notification := range configs {
+		var conf map[string]interface{}
+		if filter := notification.Filter; filter != nil {
+			conf = flattenNotificationConfigurationFilter(filter)
+		} else {
+			conf = map[string]interface{}{}
+		}
+
+		conf["id"] = *notification.Id
+		conf["events"] = schema.NewSet(schema.HashString, flattenStringList(notification.Events))
+		conf["topic_arn"] = *notification.TopicArn
+		topicNotifications = append(topicNotifications, conf)
+	}
+
+	return topicNotifications
+}
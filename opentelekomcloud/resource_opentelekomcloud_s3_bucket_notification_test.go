@@ -0,0 +1,120 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccS3BucketNotification_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckS3BucketNotificationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccS3BucketNotification_basic(rInt, "s3:ObjectCreated:*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketTopicNotification("opentelekomcloud_s3_bucket_notification.notification", 1),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket_notification.notification", "topic.0.filter_prefix", "tf-prefix/"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket_notification.notification", "topic.0.filter_suffix", ".txt"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccS3BucketNotification_basic(rInt, "s3:ObjectRemoved:*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketTopicNotification("opentelekomcloud_s3_bucket_notification.notification", 1),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket_notification.notification", "topic.0.events.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckS3BucketNotificationDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	s3conn, err := config.computeS3conn(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud s3 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_s3_bucket_notification" {
+			continue
+		}
+
+		out, err := s3conn.GetBucketNotificationConfiguration(&s3.GetBucketNotificationConfigurationRequest{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+		if err == nil && len(out.TopicConfigurations) > 0 {
+			return fmt.Errorf("S3 bucket notification still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckS3BucketTopicNotification(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		s3conn, err := config.computeS3conn(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud s3 client: %s", err)
+		}
+
+		out, err := s3conn.GetBucketNotificationConfiguration(&s3.GetBucketNotificationConfigurationRequest{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return fmt.Errorf("GetBucketNotification error: %v", err)
+		}
+
+		if len(out.TopicConfigurations) != count {
+			return fmt.Errorf("Expected %d topic notifications, got %d", count, len(out.TopicConfigurations))
+		}
+
+		return nil
+	}
+}
+
+func testAccS3BucketNotification_basic(randInt int, event string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_smn_topic_v2" "topic_1" {
+	name = "tf-test-topic-%d"
+	display_name = "The display name of topic_1"
+}
+
+resource "opentelekomcloud_s3_bucket" "bucket" {
+	bucket = "tf-test-bucket-%d"
+	acl = "public-read"
+}
+
+resource "opentelekomcloud_s3_bucket_notification" "notification" {
+	bucket = "${opentelekomcloud_s3_bucket.bucket.id}"
+	topic {
+		id = "notification-sns"
+		topic_arn = "${opentelekomcloud_smn_topic_v2.topic_1.topic_urn}"
+		events = [
+			"%s",
+		]
+		filter_prefix = "tf-prefix/"
+		filter_suffix = ".txt"
+	}
+}
+`, randInt, randInt, event)
+}
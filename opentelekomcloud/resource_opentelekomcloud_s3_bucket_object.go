@@ -2,6 +2,8 @@ package opentelekomcloud
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
@@ -11,6 +13,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/mitchellh/go-homedir"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -23,7 +26,7 @@ func resourceS3BucketObject() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceS3BucketObjectPut,
 		Read:   resourceS3BucketObjectRead,
-		Update: resourceS3BucketObjectPut,
+		Update: resourceS3BucketObjectUpdate,
 		Delete: resourceS3BucketObjectDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -116,6 +119,37 @@ func resourceS3BucketObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+
+			"source_hash": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"content_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"multipart_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100 * 1024 * 1024,
+				ValidateFunc: validation.IntAtLeast(s3MultipartMinPartSize),
+			},
+
+			"multipart_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      16 * 1024 * 1024,
+				ValidateFunc: validation.IntBetween(s3MultipartMinPartSize, s3MultipartMaxPartSize),
+			},
+
+			"multipart_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validation.IntBetween(1, 32),
+			},
 		},
 	}
 }
@@ -127,7 +161,7 @@ func resourceS3BucketObjectPut(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenTelekomCloud s3 client: %s", err)
 	}
 
-	var body io.ReadSeeker
+	var body s3ObjectBody
 
 	if v, ok := d.GetOk("source"); ok {
 		source := v.(string)
@@ -139,6 +173,7 @@ func resourceS3BucketObjectPut(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return fmt.Errorf("Error opening S3 bucket object source (%s): %s", source, err)
 		}
+		defer file.Close()
 
 		body = file
 	} else if v, ok := d.GetOk("content"); ok {
@@ -190,15 +225,42 @@ func resourceS3BucketObjectPut(d *schema.ResourceData, meta interface{}) error {
 		putInput.SSEKMSKeyId = aws.String(v.(string))
 	}
 
-	resp, err := s3conn.PutObject(putInput)
+	hash := sha256.New()
+	size, err := io.Copy(hash, body)
 	if err != nil {
-		return fmt.Errorf("Error putting object in S3 bucket (%s): %s", bucket, err)
+		return fmt.Errorf("Error reading S3 bucket object content: %s", err)
+	}
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("Error reading S3 bucket object content: %s", err)
+	}
+
+	var etag, versionID *string
+	if size >= int64(d.Get("multipart_threshold").(int)) {
+		uploader := &s3MultipartUploader{
+			conn:        s3conn,
+			partSize:    int64(d.Get("multipart_part_size").(int)),
+			concurrency: d.Get("multipart_concurrency").(int),
+		}
+		resp, err := uploader.upload(s3MultipartUploadInput(putInput), body, size)
+		if err != nil {
+			return fmt.Errorf("Error putting object in S3 bucket (%s): %s", bucket, err)
+		}
+		etag, versionID = resp.ETag, resp.VersionId
+	} else {
+		resp, err := s3conn.PutObject(putInput)
+		if err != nil {
+			return fmt.Errorf("Error putting object in S3 bucket (%s): %s", bucket, err)
+		}
+		etag, versionID = resp.ETag, resp.VersionId
 	}
 
 	// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
-	d.Set("etag", strings.Trim(*resp.ETag, `"`))
+	if v := strings.Trim(aws.StringValue(etag), `"`); isS3ContentMD5(v, putInput.ServerSideEncryption) {
+		d.Set("etag", v)
+	}
 
-	d.Set("version_id", resp.VersionId)
+	d.Set("content_sha256", hex.EncodeToString(hash.Sum(nil)))
+	d.Set("version_id", versionID)
 	d.SetId(key)
 	return resourceS3BucketObjectRead(d, meta)
 }
@@ -242,11 +304,28 @@ func resourceS3BucketObjectRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("website_redirect", resp.WebsiteRedirectLocation)
 	d.Set("sse_kms_key_id", resp.SSEKMSKeyId)
 
-	d.Set("etag", strings.Trim(*resp.ETag, `"`))
+	if v := strings.Trim(aws.StringValue(resp.ETag), `"`); isS3ContentMD5(v, resp.ServerSideEncryption) {
+		d.Set("etag", v)
+	}
 
 	return nil
 }
 
+func resourceS3BucketObjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	// The multipart settings only affect how the content is uploaded.
+	for _, k := range []string{
+		"acl", "cache_control", "content_disposition", "content_encoding", "content_language",
+		"content_type", "source", "content", "server_side_encryption", "sse_kms_key_id",
+		"etag", "website_redirect", "source_hash",
+	} {
+		if d.HasChange(k) {
+			return resourceS3BucketObjectPut(d, meta)
+		}
+	}
+
+	return resourceS3BucketObjectRead(d, meta)
+}
+
 func resourceS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	s3conn, err := config.computeS3conn(GetRegion(d, config))
@@ -292,7 +371,21 @@ func resourceS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	return nil
+	// Incomplete multipart uploads are kept for resuming and still use storage.
+	return abortS3MultipartUploads(s3conn, bucket, key)
+}
+
+// s3ObjectBody is the content of an object, read once to hash it and then
+// either sent whole or in parts.
+type s3ObjectBody interface {
+	io.ReadSeeker
+	io.ReaderAt
+}
+
+// isS3ContentMD5 returns true if etag is the MD5 of the object content, which
+// is not the case for multipart uploads and objects encrypted with KMS.
+func isS3ContentMD5(etag string, serverSideEncryption *string) bool {
+	return etag != "" && !strings.Contains(etag, "-") && aws.StringValue(serverSideEncryption) != s3.ServerSideEncryptionAwsKms
 }
 
 func validateS3BucketObjectAclType(v interface{}, k string) (ws []string, errors []error) {
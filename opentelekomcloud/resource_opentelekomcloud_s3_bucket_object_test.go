@@ -1,6 +1,9 @@
 package opentelekomcloud
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -45,6 +48,40 @@ func TestAccS3BucketObject_source(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketObject_multipart(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "tf-acc-s3-obj-multipart")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	rInt := acctest.RandInt()
+	// 12 MiB are uploaded in three parts of at most 5 MiB.
+	content := bytes.Repeat([]byte("0123456789abcdef"), 12*1024*1024/16)
+	err = ioutil.WriteFile(tmpFile.Name(), content, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256(content)
+	var obj s3.GetObjectOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccS3BucketObjectConfig_multipart(rInt, tmpFile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketObjectExists("opentelekomcloud_s3_bucket_object.object", &obj),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket_object.object", "content_sha256", hex.EncodeToString(sum[:])),
+				),
+			},
+		},
+	})
+}
+
 // PASS
 func TestAccS3BucketObject_content(t *testing.T) {
 	rInt := acctest.RandInt()
@@ -481,6 +518,22 @@ resource "opentelekomcloud_s3_bucket_object" "object" {
 `, randInt, source)
 }
 
+func testAccS3BucketObjectConfig_multipart(randInt int, source string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_s3_bucket" "object_bucket" {
+    bucket = "tf-object-test-bucket-%d"
+}
+resource "opentelekomcloud_s3_bucket_object" "object" {
+	bucket = "${opentelekomcloud_s3_bucket.object_bucket.bucket}"
+	key = "test-key"
+	source = "%s"
+	multipart_threshold = 5242880
+	multipart_part_size = 5242880
+	multipart_concurrency = 2
+}
+`, randInt, source)
+}
+
 func testAccS3BucketObjectConfig_withContentCharacteristics(randInt int, source string) string {
 	return fmt.Sprintf(`
 resource "opentelekomcloud_s3_bucket" "object_bucket_2" {
@@ -0,0 +1,430 @@
+package opentelekomcloud
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/mitchellh/go-homedir"
+)
+
+func resourceS3BucketObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceS3BucketObjectsSyncCreate,
+		Read:   resourceS3BucketObjectsSyncRead,
+		Update: resourceS3BucketObjectsSyncUpdate,
+		Delete: resourceS3BucketObjectsSyncDelete,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"exclude": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "private",
+				ValidateFunc: validateS3BucketObjectAclType,
+			},
+
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"content_types": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			// files records the SHA-256 sums of the uploaded objects. It is not
+			// set in the configuration, the entries in sync with source_dir are
+			// suppressed from the diff and the others plan an update.
+			"files": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				ValidateFunc:     validateS3SyncFiles,
+				DiffSuppressFunc: suppressS3SyncFilesDiff,
+			},
+		},
+	}
+}
+
+// s3SyncSHA256Metadata is the metadata key of the SHA-256 sum of the content
+// of the uploaded objects.
+const s3SyncSHA256Metadata = "sha256"
+
+// s3SyncFile is a local file to be mirrored into the bucket.
+type s3SyncFile struct {
+	path        string
+	contentType string
+	sha256      string
+}
+
+func resourceS3BucketObjectsSyncCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("bucket").(string), d.Get("prefix").(string)))
+
+	if err := resourceS3BucketObjectsSyncPut(d, meta, nil); err != nil {
+		return err
+	}
+
+	return resourceS3BucketObjectsSyncRead(d, meta)
+}
+
+func resourceS3BucketObjectsSyncUpdate(d *schema.ResourceData, meta interface{}) error {
+	previous, _ := d.GetChange("files")
+	if err := resourceS3BucketObjectsSyncPut(d, meta, previous.(map[string]interface{})); err != nil {
+		return err
+	}
+
+	return resourceS3BucketObjectsSyncRead(d, meta)
+}
+
+// resourceS3BucketObjectsSyncPut uploads new and changed files and deletes
+// the objects of removed files. Only the objects uploaded earlier, recorded
+// in the previous files, are deleted: other objects of the bucket are left
+// alone.
+func resourceS3BucketObjectsSyncPut(d *schema.ResourceData, meta interface{}, previous map[string]interface{}) error {
+	config := meta.(*Config)
+	s3conn, err := config.computeS3conn(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud s3 client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	local, err := s3SyncLocalFiles(d)
+	if err != nil {
+		return err
+	}
+
+	// Content types and headers are not compared, so changing them uploads all
+	// files.
+	reupload := d.HasChange("acl") || d.HasChange("cache_control") || d.HasChange("content_types")
+
+	files := make(map[string]string, len(local))
+	for key, f := range local {
+		files[key] = f.sha256
+		if sum, ok := previous[key]; ok && sum.(string) == f.sha256 && !reupload {
+			continue
+		}
+		if err := s3SyncUpload(s3conn, d, key, f); err != nil {
+			d.Set("files", s3SyncRecordedFiles(previous, files))
+			return err
+		}
+	}
+
+	for key := range previous {
+		if _, ok := local[key]; ok {
+			continue
+		}
+		log.Printf("[DEBUG] Deleting %s from S3 bucket %s", key, bucket)
+		_, err := s3conn.DeleteObject(&s3.DeleteObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			d.Set("files", s3SyncRecordedFiles(previous, files))
+			return fmt.Errorf("Error deleting S3 bucket object: %s  Bucket: %q Object: %q", err, bucket, key)
+		}
+	}
+
+	return d.Set("files", files)
+}
+
+// s3SyncRecordedFiles returns the files to record after a failed sync: the
+// uploaded ones and the previous ones, so that their objects are still
+// deleted later.
+func s3SyncRecordedFiles(previous map[string]interface{}, uploaded map[string]string) map[string]string {
+	files := make(map[string]string, len(previous)+len(uploaded))
+	for key, sum := range previous {
+		files[key] = sum.(string)
+	}
+	for key, sum := range uploaded {
+		files[key] = sum
+	}
+	return files
+}
+
+// resourceS3BucketObjectsSyncRead records the SHA-256 sums of the uploaded
+// objects, taken from their metadata. Objects removed from the bucket are
+// dropped from files, objects changed outside of Terraform get the sum of
+// their new content or none. Both plan an update.
+func resourceS3BucketObjectsSyncRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	s3conn, err := config.computeS3conn(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud s3 client: %s", err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	files := make(map[string]string)
+	for key := range d.Get("files").(map[string]interface{}) {
+		out, err := s3conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			if awsErr, ok := err.(awserr.RequestFailure); ok && awsErr.StatusCode() == 404 {
+				log.Printf("[DEBUG] Object %s of S3 bucket %s is gone", key, bucket)
+				continue
+			}
+			return fmt.Errorf("Error reading S3 bucket object %s: %s", key, err)
+		}
+		files[key] = s3SyncObjectSHA256(out.Metadata)
+	}
+
+	if err := d.Set("files", files); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceS3BucketObjectsSyncDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	s3conn, err := config.computeS3conn(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud s3 client: %s", err)
+	}
+
+	// Only the objects uploaded by this resource are deleted.
+	bucket := d.Get("bucket").(string)
+	for key := range d.Get("files").(map[string]interface{}) {
+		log.Printf("[DEBUG] Deleting %s from S3 bucket %s", key, bucket)
+		_, err := s3conn.DeleteObject(&s3.DeleteObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			return fmt.Errorf("Error deleting S3 bucket object: %s  Bucket: %q Object: %q", err, bucket, key)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func validateS3SyncFiles(v interface{}, k string) (ws []string, errors []error) {
+	if len(v.(map[string]interface{})) > 0 {
+		errors = append(errors, fmt.Errorf("%q is computed from source_dir and cannot be set", k))
+	}
+	return
+}
+
+// suppressS3SyncFilesDiff suppresses the diff of the recorded files which are
+// in sync with source_dir. The files are never configured, so every recorded
+// file is planned for removal and its count changes to 0 otherwise. A new
+// local file changes the count, a missing source_dir shows all the files.
+func suppressS3SyncFilesDiff(k, old, new string, d *schema.ResourceData) bool {
+	local, err := s3SyncLocalFiles(d)
+	if err != nil {
+		log.Printf("[WARN] %s", err)
+		return false
+	}
+
+	if k == "files.%" {
+		return old == strconv.Itoa(len(local))
+	}
+	f, ok := local[strings.TrimPrefix(k, "files.")]
+	return ok && f.sha256 == old
+}
+
+func s3SyncUpload(s3conn *s3.S3, d *schema.ResourceData, key string, f *s3SyncFile) error {
+	file, err := os.Open(f.path)
+	if err != nil {
+		return fmt.Errorf("Error opening %s: %s", f.path, err)
+	}
+	defer file.Close()
+
+	input := &s3.PutObjectInput{
+		Bucket:      aws.String(d.Get("bucket").(string)),
+		Key:         aws.String(key),
+		ACL:         aws.String(d.Get("acl").(string)),
+		ContentType: aws.String(f.contentType),
+		Metadata:    map[string]*string{s3SyncSHA256Metadata: aws.String(f.sha256)},
+		Body:        file,
+	}
+	if v, ok := d.GetOk("cache_control"); ok {
+		input.CacheControl = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Uploading %s to S3 bucket %s as %s (%s)", f.path, *input.Bucket, key, f.contentType)
+	if _, err := s3conn.PutObject(input); err != nil {
+		return fmt.Errorf("Error putting object in S3 bucket (%s): %s", *input.Bucket, err)
+	}
+	return nil
+}
+
+// s3SyncLocalFiles returns the files of source_dir which are not excluded by
+// their object keys.
+func s3SyncLocalFiles(d *schema.ResourceData) (map[string]*s3SyncFile, error) {
+	sourceDir, err := homedir.Expand(d.Get("source_dir").(string))
+	if err != nil {
+		return nil, fmt.Errorf("Error expanding homedir in source_dir (%s): %s", d.Get("source_dir").(string), err)
+	}
+	prefix := d.Get("prefix").(string)
+	excludes := s3SyncExcludes(d)
+	contentTypes := d.Get("content_types").(map[string]interface{})
+
+	files := make(map[string]*s3SyncFile)
+	err = filepath.Walk(sourceDir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(sourceDir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if rel == "." {
+			return nil
+		}
+		if s3SyncExcluded(rel, excludes) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		f := &s3SyncFile{
+			path:        p,
+			contentType: s3SyncContentType(rel, contentTypes),
+		}
+		if f.sha256, err = s3SyncFileSHA256(p, info); err != nil {
+			return err
+		}
+		files[prefix+rel] = f
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading source_dir (%s): %s", sourceDir, err)
+	}
+
+	return files, nil
+}
+
+// s3SyncObjectSHA256 returns the SHA-256 sum recorded in the metadata of an
+// uploaded object. The ETag is not a content hash for objects encrypted with
+// KMS or uploaded in parts.
+func s3SyncObjectSHA256(metadata map[string]*string) string {
+	for k, v := range metadata {
+		if strings.EqualFold(k, s3SyncSHA256Metadata) {
+			return aws.StringValue(v)
+		}
+	}
+	return ""
+}
+
+func s3SyncExcludes(d *schema.ResourceData) []string {
+	var excludes []string
+	for _, v := range d.Get("exclude").(*schema.Set).List() {
+		excludes = append(excludes, v.(string))
+	}
+	return excludes
+}
+
+// s3SyncExcluded returns true if the slash separated relative path, or one of
+// its parent directories, matches one of the glob patterns. Patterns without
+// a "/" also match the base name of the path at any depth.
+func s3SyncExcluded(rel string, patterns []string) bool {
+	elems := strings.Split(rel, "/")
+	for _, pattern := range patterns {
+		for i := range elems {
+			if ok, _ := path.Match(pattern, strings.Join(elems[:i+1], "/")); ok {
+				return true
+			}
+			if !strings.Contains(pattern, "/") {
+				if ok, _ := path.Match(pattern, elems[i]); ok {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// s3SyncContentType infers the content type of a file from its extension.
+// The content_types overrides are keyed by extension including the dot.
+func s3SyncContentType(rel string, overrides map[string]interface{}) string {
+	ext := strings.ToLower(path.Ext(rel))
+	if v, ok := overrides[ext]; ok {
+		return v.(string)
+	}
+	if t := mime.TypeByExtension(ext); t != "" {
+		return t
+	}
+	return "application/octet-stream"
+}
+
+// s3SyncHashes caches the SHA-256 sums of the local files, the diff of each
+// recorded file walks source_dir.
+var s3SyncHashes = struct {
+	sync.Mutex
+	sums map[string]string
+}{sums: make(map[string]string)}
+
+// s3SyncFileSHA256 returns the hex encoded SHA-256 sum of a file. The sum is
+// computed again when the size or the modification time of the file change.
+func s3SyncFileSHA256(p string, info os.FileInfo) (string, error) {
+	cacheKey := fmt.Sprintf("%s|%d|%d", p, info.Size(), info.ModTime().UnixNano())
+
+	s3SyncHashes.Lock()
+	sum, ok := s3SyncHashes.sums[cacheKey]
+	s3SyncHashes.Unlock()
+	if ok {
+		return sum, nil
+	}
+
+	file, err := os.Open(p)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	sum = hex.EncodeToString(hash.Sum(nil))
+
+	s3SyncHashes.Lock()
+	s3SyncHashes.sums[cacheKey] = sum
+	s3SyncHashes.Unlock()
+	return sum, nil
+}
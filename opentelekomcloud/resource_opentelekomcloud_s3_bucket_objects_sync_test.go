@@ -0,0 +1,233 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestS3SyncExcluded(t *testing.T) {
+	cases := []struct {
+		rel      string
+		patterns []string
+		excluded bool
+	}{
+		{"index.html", nil, false},
+		{"index.html", []string{"*.tmp"}, false},
+		{"index.tmp", []string{"*.tmp"}, true},
+		{"css/site.tmp", []string{"*.tmp"}, true},
+		{".git/config", []string{".git"}, true},
+		{"docs/.git/config", []string{".git"}, true},
+		{"drafts/post.md", []string{"drafts/*"}, true},
+		{"blog/drafts/post.md", []string{"drafts/*"}, false},
+		{"blog/drafts/post.md", []string{"blog/drafts"}, true},
+		{"images/logo.png", []string{"*.tmp", "images"}, true},
+	}
+
+	for _, tc := range cases {
+		if got := s3SyncExcluded(tc.rel, tc.patterns); got != tc.excluded {
+			t.Errorf("s3SyncExcluded(%q, %q): expected %t, got %t", tc.rel, tc.patterns, tc.excluded, got)
+		}
+	}
+}
+
+func TestS3SyncContentType(t *testing.T) {
+	overrides := map[string]interface{}{
+		".md":   "text/markdown",
+		".html": "text/html; charset=utf-8",
+	}
+
+	cases := []struct {
+		rel         string
+		contentType string
+	}{
+		{"index.html", "text/html; charset=utf-8"},
+		{"README.MD", "text/markdown"},
+		{"images/logo.png", "image/png"},
+		{"bin/tool", "application/octet-stream"},
+		{"data.unknown-ext", "application/octet-stream"},
+	}
+
+	for _, tc := range cases {
+		if got := s3SyncContentType(tc.rel, overrides); got != tc.contentType {
+			t.Errorf("s3SyncContentType(%q): expected %q, got %q", tc.rel, tc.contentType, got)
+		}
+	}
+}
+
+func TestSuppressS3SyncFilesDiff(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-s3-objects-sync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "index.html"), []byte("hello"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// SHA-256 sum of "hello"
+	sum := "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+
+	d := schema.TestResourceDataRaw(t, resourceS3BucketObjectsSync().Schema, map[string]interface{}{
+		"bucket":     "bucket",
+		"prefix":     "site/",
+		"source_dir": dir,
+	})
+
+	cases := []struct {
+		k, old   string
+		suppress bool
+	}{
+		{"files.%", "1", true},
+		{"files.%", "2", false},
+		{"files.site/index.html", sum, true},
+		{"files.site/index.html", "", false},
+		{"files.site/removed.html", sum, false},
+	}
+
+	for _, tc := range cases {
+		if got := suppressS3SyncFilesDiff(tc.k, tc.old, "", d); got != tc.suppress {
+			t.Errorf("suppressS3SyncFilesDiff(%q, %q): expected %t, got %t", tc.k, tc.old, tc.suppress, got)
+		}
+	}
+
+	d.Set("source_dir", filepath.Join(dir, "missing"))
+	if suppressS3SyncFilesDiff("files.site/index.html", sum, "", d) {
+		t.Errorf("Expected the diff not to be suppressed without source_dir")
+	}
+}
+
+func TestAccS3BucketObjectsSync_basic(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-acc-s3-objects-sync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"index.html":      "<html></html>",
+		"css/site.css":    "body {}",
+		"notes/draft.tmp": "not uploaded",
+	}
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rInt := acctest.RandInt()
+	resourceName := "opentelekomcloud_s3_bucket_objects_sync.site"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckS3BucketObjectsSyncDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccS3BucketObjectsSyncConfig(rInt, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					testAccCheckS3BucketObjectsSyncObject(resourceName, "site/index.html", "text/html; charset=utf-8"),
+					testAccCheckS3BucketObjectsSyncObject(resourceName, "site/css/site.css", "text/css; charset=utf-8"),
+				),
+			},
+			resource.TestStep{
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(dir, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+					if err := ioutil.WriteFile(filepath.Join(dir, "about.txt"), []byte("about"), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccS3BucketObjectsSyncConfig(rInt, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					testAccCheckS3BucketObjectsSyncObject(resourceName, "site/about.txt", "text/plain; charset=utf-8"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckS3BucketObjectsSyncDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	s3conn, err := config.computeS3conn(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud s3 client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_s3_bucket_objects_sync" {
+			continue
+		}
+
+		out, err := s3conn.ListObjects(&s3.ListObjectsInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Prefix: aws.String(rs.Primary.Attributes["prefix"]),
+		})
+		if err == nil && len(out.Contents) > 0 {
+			return fmt.Errorf("S3 bucket objects still exist: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckS3BucketObjectsSyncObject(n, key, contentType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		s3conn, err := config.computeS3conn(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud s3 client: %s", err)
+		}
+		out, err := s3conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			return fmt.Errorf("S3 bucket object %s error: %s", key, err)
+		}
+		if aws.StringValue(out.ContentType) != contentType {
+			return fmt.Errorf("S3 bucket object %s: expected content type %q, got %q",
+				key, contentType, aws.StringValue(out.ContentType))
+		}
+
+		return nil
+	}
+}
+
+func testAccS3BucketObjectsSyncConfig(randInt int, dir string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_s3_bucket" "bucket" {
+	bucket = "tf-objects-sync-test-bucket-%d"
+}
+
+resource "opentelekomcloud_s3_bucket_objects_sync" "site" {
+	bucket = "${opentelekomcloud_s3_bucket.bucket.bucket}"
+	prefix = "site/"
+	source_dir = "%s"
+	exclude = ["*.tmp"]
+	content_types = {
+		".html" = "text/html; charset=utf-8"
+	}
+}
+`, randInt, filepath.ToSlash(dir))
+}
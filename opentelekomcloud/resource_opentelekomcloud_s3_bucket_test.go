@@ -569,6 +569,61 @@ func TestAccS3Bucket_Lifecycle(t *testing.T) {
 	})
 }
 
+func TestAccS3Bucket_Encryption(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccS3BucketConfigWithEncryption(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketExists("opentelekomcloud_s3_bucket.bucket"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket.bucket", "server_side_encryption_configuration.#", "1"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket.bucket", "server_side_encryption_configuration.0.rule.0.apply_server_side_encryption_by_default.0.sse_algorithm", "aws:kms"),
+					resource.TestCheckResourceAttrPair(
+						"opentelekomcloud_s3_bucket.bucket", "server_side_encryption_configuration.0.rule.0.apply_server_side_encryption_by_default.0.kms_master_key_id",
+						"opentelekomcloud_kms_key_v1.key_1", "id"),
+				),
+			},
+			{
+				Config: testAccS3BucketConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketExists("opentelekomcloud_s3_bucket.bucket"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket.bucket", "server_side_encryption_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Bucket_Replication(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckS3Replication(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckS3BucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccS3BucketConfigReplication(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckS3BucketExists("opentelekomcloud_s3_bucket.bucket"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket.bucket", "replication_configuration.#", "1"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket.bucket", "replication_configuration.0.role", OS_OBS_AGENCY),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_s3_bucket.bucket", "replication_configuration.0.rules.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestS3BucketName(t *testing.T) {
 	validDnsNames := []string{
 		"foobar",
@@ -1314,6 +1369,60 @@ resource "opentelekomcloud_s3_bucket" "bucket" {
 `, randInt)
 }
 
+func testAccS3BucketConfigWithEncryption(randInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_kms_key_v1" "key_1" {
+	key_alias = "tf-test-bucket-key-%d"
+	pending_days = "7"
+}
+
+resource "opentelekomcloud_s3_bucket" "bucket" {
+	bucket = "tf-test-bucket-%d"
+	acl = "public-read"
+	server_side_encryption_configuration {
+		rule {
+			apply_server_side_encryption_by_default {
+				kms_master_key_id = "${opentelekomcloud_kms_key_v1.key_1.id}"
+				sse_algorithm = "aws:kms"
+			}
+		}
+	}
+}
+`, randInt, randInt)
+}
+
+func testAccS3BucketConfigReplication(randInt int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_s3_bucket" "destination" {
+	bucket = "tf-test-bucket-destination-%d"
+	versioning {
+		enabled = true
+	}
+}
+
+resource "opentelekomcloud_s3_bucket" "bucket" {
+	bucket = "tf-test-bucket-%d"
+	acl = "private"
+	versioning {
+		enabled = true
+	}
+	replication_configuration {
+		role = "%s"
+		rules {
+			id = "foobar"
+			prefix = "foo"
+			status = "Enabled"
+
+			destination {
+				bucket = "arn:aws:s3:::${opentelekomcloud_s3_bucket.destination.bucket}"
+				storage_class = "STANDARD"
+			}
+		}
+	}
+}
+`, randInt, randInt, OS_OBS_AGENCY)
+}
+
 const testAccS3BucketConfig_namePrefix = `
 resource "opentelekomcloud_s3_bucket" "test" {
 	bucket_prefix = "tf-test-"
@@ -0,0 +1,208 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/sfs/v2/shares"
+)
+
+func resourceSFSAccessRuleV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSFSAccessRuleV2Create,
+		Read:   resourceSFSAccessRuleV2Read,
+		Delete: resourceSFSAccessRuleV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceSFSAccessRuleV2Import,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"share_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"access_to": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"access_level": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"rw", "ro"})
+				},
+			},
+			"access_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "cert",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"cert", "ip", "user"})
+				},
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSFSAccessRuleV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sfsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud File Share Client: %s", err)
+	}
+
+	shareID := d.Get("share_id").(string)
+	grantAccessOpts := shares.GrantAccessOpts{
+		AccessLevel: d.Get("access_level").(string),
+		AccessType:  d.Get("access_type").(string),
+		AccessTo:    d.Get("access_to").(string),
+	}
+
+	log.Printf("[DEBUG] Grant Access Rules: %#v", grantAccessOpts)
+	grant, err := shares.GrantAccess(sfsClient, shareID, grantAccessOpts).ExtractAccess()
+	if err != nil {
+		return fmt.Errorf("Error applying access rule to share file %s: %s", shareID, err)
+	}
+	d.SetId(grant.ID)
+
+	log.Printf("[DEBUG] Waiting for OpenTelekomCloud SFS access rule (%s) to become active", grant.ID)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"new", "queued_to_apply", "applying"},
+		Target:     []string{"active"},
+		Refresh:    waitForSFSAccessRule(sfsClient, shareID, grant.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenTelekomCloud SFS access rule (%s) to become active: %s", grant.ID, err)
+	}
+
+	return resourceSFSAccessRuleV2Read(d, meta)
+}
+
+func resourceSFSAccessRuleV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sfsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud File Share Client: %s", err)
+	}
+
+	rule, err := getSFSAccessRule(sfsClient, d.Get("share_id").(string), d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "SFS access rule")
+	}
+
+	d.Set("access_to", rule.AccessTo)
+	d.Set("access_level", rule.AccessLevel)
+	d.Set("access_type", rule.AccessType)
+	d.Set("status", rule.State)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceSFSAccessRuleV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sfsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud File Share Client: %s", err)
+	}
+
+	shareID := d.Get("share_id").(string)
+	deleteAccessOpts := shares.DeleteAccessOpts{AccessID: d.Id()}
+	err = shares.DeleteAccess(sfsClient, shareID, deleteAccessOpts).Err
+	if err != nil {
+		return CheckDeleted(d, err, "SFS access rule")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active", "queued_to_deny", "denying"},
+		Target:     []string{"deleted"},
+		Refresh:    waitForSFSAccessRule(sfsClient, shareID, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error deleting OpenTelekomCloud SFS access rule (%s): %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceSFSAccessRuleV2Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		err := fmt.Errorf("Invalid format specified for SFS access rule. Format must be <share id>/<rule id>")
+		return nil, err
+	}
+
+	shareID := parts[0]
+	ruleID := parts[1]
+
+	d.SetId(ruleID)
+	d.Set("share_id", shareID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getSFSAccessRule looks up a single access rule of a share, as the API
+// only supports listing all rules of a share.
+func getSFSAccessRule(sfsClient *golangsdk.ServiceClient, shareID, ruleID string) (*shares.AccessRight, error) {
+	rules, err := shares.ListAccessRights(sfsClient, shareID).ExtractAccessRights()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range rules {
+		if rule.ID == ruleID {
+			return &rule, nil
+		}
+	}
+
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func waitForSFSAccessRule(sfsClient *golangsdk.ServiceClient, shareID, ruleID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		rule, err := getSFSAccessRule(sfsClient, shareID, ruleID)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				log.Printf("[INFO] OpenTelekomCloud SFS access rule %s does not exist", ruleID)
+				return shares.AccessRight{}, "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] OpenTelekomCloud SFS access rule (%s) current state: %s", ruleID, rule.State)
+		return rule, rule.State, nil
+	}
+}
@@ -0,0 +1,114 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/huaweicloud/golangsdk/openstack/sfs/v2/shares"
+)
+
+func TestAccOTCSFSAccessRuleV2_basic(t *testing.T) {
+	var rule shares.AccessRight
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSFSAccessRuleV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSFSAccessRuleV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSAccessRuleV2Exists("opentelekomcloud_sfs_access_rule_v2.rule_1", &rule),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_sfs_access_rule_v2.rule_1", "access_to", OS_VPC_ID),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_sfs_access_rule_v2.rule_1", "access_level", "rw"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_sfs_access_rule_v2.rule_1", "status", "active"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_sfs_access_rule_v2.rule_2", "access_level", "ro"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_sfs_file_system_v2.sfs_1", "share_access_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSFSAccessRuleV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	sfsClient, err := config.sfsV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating opentelekomcloud sfs client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_sfs_access_rule_v2" {
+			continue
+		}
+
+		_, err := getSFSAccessRule(sfsClient, rs.Primary.Attributes["share_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("SFS access rule still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSFSAccessRuleV2Exists(n string, rule *shares.AccessRight) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		sfsClient, err := config.sfsV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating opentelekomcloud sfs client: %s", err)
+		}
+
+		found, err := getSFSAccessRule(sfsClient, rs.Primary.Attributes["share_id"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*rule = *found
+
+		return nil
+	}
+}
+
+var testAccSFSAccessRuleV2_basic = fmt.Sprintf(`
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+  name = "vpc_sfs_test"
+  cidr = "192.168.0.0/16"
+}
+
+resource "opentelekomcloud_sfs_file_system_v2" "sfs_1" {
+  share_proto = "NFS"
+  size = 1
+  name = "sfs-test-rules"
+  availability_zone = "eu-de-01"
+  description = "sfs_c2c_test-file"
+}
+
+resource "opentelekomcloud_sfs_access_rule_v2" "rule_1" {
+  share_id = "${opentelekomcloud_sfs_file_system_v2.sfs_1.id}"
+  access_to = "%s"
+  access_level = "rw"
+}
+
+resource "opentelekomcloud_sfs_access_rule_v2" "rule_2" {
+  share_id = "${opentelekomcloud_sfs_file_system_v2.sfs_1.id}"
+  access_to = "${opentelekomcloud_vpc_v1.vpc_1.id}"
+  access_level = "ro"
+}
+`, OS_VPC_ID)
@@ -7,6 +7,7 @@ import (
 	"github.com/huaweicloud/golangsdk"
 	"github.com/huaweicloud/golangsdk/openstack/sfs/v2/shares"
 	"log"
+	"strings"
 	"time"
 )
 
@@ -17,7 +18,7 @@ func resourceSFSFileSystemV2() *schema.Resource {
 		Update: resourceSFSFileSystemV2Update,
 		Delete: resourceSFSFileSystemV2Delete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceSFSFileSystemV2Import,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -71,7 +72,7 @@ func resourceSFSFileSystemV2() *schema.Resource {
 			},
 			"access_level": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 			"access_type": &schema.Schema{
 				Type:     schema.TypeString,
@@ -80,7 +81,7 @@ func resourceSFSFileSystemV2() *schema.Resource {
 			},
 			"access_to": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 			"share_access_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -98,6 +99,8 @@ func resourceSFSFileSystemV2() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -140,20 +143,18 @@ func resourceSFSFileSystemV2Create(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error applying access rules to share file : %s", StateErr)
 	}
 
-	grantAccessOpts := shares.GrantAccessOpts{
-		AccessLevel: d.Get("access_level").(string),
-		AccessType:  d.Get("access_type").(string),
-		AccessTo:    d.Get("access_to").(string),
+	if _, ok := d.GetOk("access_to"); ok {
+		if err := resourceSFSFileSystemV2GrantAccess(d, sfsClient); err != nil {
+			return err
+		}
 	}
 
-	grant, accessErr := shares.GrantAccess(sfsClient, d.Id(), grantAccessOpts).ExtractAccess()
-
-	if accessErr != nil {
-		return fmt.Errorf("Error applying access rules to share file : %s", accessErr)
+	if len(resourceTags(d, meta)) > 0 {
+		if err := setResourceTags(d, meta, resourceSFSFileSystemV2TagManager(sfsClient)); err != nil {
+			return fmt.Errorf("Error setting tags of OpenTelekomCloud Share File %s: %s", d.Id(), err)
+		}
 	}
 
-	log.Printf("[DEBUG] Waiting for OpenTelekomCloud SFS File Share (%s) to become available", grant.ID)
-
 	return resourceSFSFileSystemV2Read(d, meta)
 
 }
@@ -191,6 +192,10 @@ func resourceSFSFileSystemV2Read(d *schema.ResourceData, meta interface{}) error
 	d.Set("host", n.Host)
 	d.Set("links", n.Links)
 
+	if err := readResourceTags(d, meta, resourceSFSFileSystemV2TagManager(sfsClient)); err != nil {
+		return fmt.Errorf("Error retrieving tags of OpenTelekomCloud Share File %s: %s", d.Id(), err)
+	}
+
 	rules, err := shares.ListAccessRights(sfsClient, d.Id()).ExtractAccessRights()
 
 	if err != nil {
@@ -202,14 +207,27 @@ func resourceSFSFileSystemV2Read(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error retrieving OpenTelekomCloud Shares: %s", err)
 	}
 
-	if len(rules) > 0 {
-		rule := rules[0]
-		d.Set("share_access_id", rule.ID)
-		d.Set("access_rule_status", rule.State)
-		d.Set("access_to", rule.AccessTo)
-		d.Set("access_type", rule.AccessType)
-		d.Set("access_level", rule.AccessLevel)
+	// Only the inline grant is tracked here, other rules of the share are
+	// managed by opentelekomcloud_sfs_access_rule_v2
+	shareAccessID := d.Get("share_access_id").(string)
+	if shareAccessID == "" {
+		return nil
+	}
+	for _, rule := range rules {
+		if rule.ID == shareAccessID {
+			d.Set("access_rule_status", rule.State)
+			d.Set("access_to", rule.AccessTo)
+			d.Set("access_type", rule.AccessType)
+			d.Set("access_level", rule.AccessLevel)
+			return nil
+		}
 	}
+
+	log.Printf("[WARN] Access rule %s of OpenTelekomCloud Share File %s not found", shareAccessID, d.Id())
+	d.Set("share_access_id", "")
+	d.Set("access_rule_status", "")
+	d.Set("access_to", "")
+	d.Set("access_level", "")
 	return nil
 }
 
@@ -231,23 +249,20 @@ func resourceSFSFileSystemV2Update(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 	if d.HasChange("access_to") || d.HasChange("access_level") || d.HasChange("access_type") {
-		deleteAccessOpts := shares.DeleteAccessOpts{AccessID: d.Get("share_access_id").(string)}
-		deny := shares.DeleteAccess(sfsClient, d.Id(), deleteAccessOpts)
-		if deny.Err != nil {
-			return fmt.Errorf("Error changing access rules for share file : %s", deny.Err)
-		}
-
-		grantAccessOpts := shares.GrantAccessOpts{
-			AccessLevel: d.Get("access_level").(string),
-			AccessType:  d.Get("access_type").(string),
-			AccessTo:    d.Get("access_to").(string),
+		if shareAccessID := d.Get("share_access_id").(string); shareAccessID != "" {
+			deleteAccessOpts := shares.DeleteAccessOpts{AccessID: shareAccessID}
+			deny := shares.DeleteAccess(sfsClient, d.Id(), deleteAccessOpts)
+			if deny.Err != nil {
+				return fmt.Errorf("Error changing access rules for share file : %s", deny.Err)
+			}
+			d.Set("share_access_id", "")
+			d.Set("access_rule_status", "")
 		}
 
-		log.Printf("[DEBUG] Grant Access Rules: %#v", grantAccessOpts)
-		_, accessErr := shares.GrantAccess(sfsClient, d.Id(), grantAccessOpts).ExtractAccess()
-
-		if accessErr != nil {
-			return fmt.Errorf("Error changing access rules for share file : %s", accessErr)
+		if _, ok := d.GetOk("access_to"); ok {
+			if err := resourceSFSFileSystemV2GrantAccess(d, sfsClient); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -268,6 +283,12 @@ func resourceSFSFileSystemV2Update(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	if resourceTagsChanged(d, meta) {
+		if err := setResourceTags(d, meta, resourceSFSFileSystemV2TagManager(sfsClient)); err != nil {
+			return fmt.Errorf("Error updating tags of OpenTelekomCloud Share File: %s", err)
+		}
+	}
+
 	return resourceSFSFileSystemV2Read(d, meta)
 }
 
@@ -296,6 +317,83 @@ func resourceSFSFileSystemV2Delete(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
+func resourceSFSFileSystemV2GrantAccess(d *schema.ResourceData, sfsClient *golangsdk.ServiceClient) error {
+	grantAccessOpts := shares.GrantAccessOpts{
+		AccessLevel: d.Get("access_level").(string),
+		AccessType:  d.Get("access_type").(string),
+		AccessTo:    d.Get("access_to").(string),
+	}
+	if grantAccessOpts.AccessLevel == "" {
+		return fmt.Errorf("The argument (access_level) is required when (access_to) is set")
+	}
+
+	log.Printf("[DEBUG] Grant Access Rules: %#v", grantAccessOpts)
+	grant, err := shares.GrantAccess(sfsClient, d.Id(), grantAccessOpts).ExtractAccess()
+	if err != nil {
+		return fmt.Errorf("Error applying access rules to share file : %s", err)
+	}
+	d.Set("share_access_id", grant.ID)
+
+	log.Printf("[DEBUG] Waiting for OpenTelekomCloud SFS access rule (%s) to become active", grant.ID)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"new", "queued_to_apply", "applying"},
+		Target:     []string{"active"},
+		Refresh:    waitForSFSAccessRule(sfsClient, d.Id(), grant.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenTelekomCloud SFS access rule (%s) to become active: %s", grant.ID, err)
+	}
+
+	return nil
+}
+
+// resourceSFSFileSystemV2TagManager returns the tagManager of the shares, the
+// endpoint of the SFS service already contains the project ID.
+func resourceSFSFileSystemV2TagManager(sfsClient *golangsdk.ServiceClient) *tagManager {
+	return &tagManager{
+		client:  sfsClient,
+		srvType: "sfs",
+	}
+}
+
+// resourceSFSFileSystemV2Import imports a share as <share_id>, leaving the
+// inline access rule empty, or as <share_id>/<access_id> to adopt one of the
+// access rules of the share as the inline access rule.
+func resourceSFSFileSystemV2Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) == 1 {
+		return []*schema.ResourceData{d}, nil
+	}
+	if parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Invalid format specified for Share File. Format must be <share_id> or <share_id>/<access_id>")
+	}
+	shareID, accessID := parts[0], parts[1]
+
+	config := meta.(*Config)
+	sfsClient, err := config.sfsV2Client(GetRegion(d, config))
+	if err != nil {
+		return nil, fmt.Errorf("Error creating OpenTelekomCloud File Share Client: %s", err)
+	}
+
+	rules, err := shares.ListAccessRights(sfsClient, shareID).ExtractAccessRights()
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving access rules of OpenTelekomCloud Share File %s: %s", shareID, err)
+	}
+	for _, rule := range rules {
+		if rule.ID == accessID {
+			d.SetId(shareID)
+			d.Set("share_access_id", accessID)
+			return []*schema.ResourceData{d}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Access rule %s not found on OpenTelekomCloud Share File %s", accessID, shareID)
+}
+
 func waitForSFSFileActive(sfsClient *golangsdk.ServiceClient, shareID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		n, err := shares.Get(sfsClient, shareID).Extract()
@@ -0,0 +1,322 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/sfs_turbo/v1/shares"
+)
+
+func resourceSFSTurboV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSFSTurboV1Create,
+		Read:   resourceSFSTurboV1Read,
+		Update: resourceSFSTurboV1Update,
+		Delete: resourceSFSTurboV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateIntRange(v, k, 500, 32768)
+				},
+			},
+			"share_proto": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "NFS",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"NFS"})
+				},
+			},
+			"share_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "STANDARD",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"STANDARD", "PERFORMANCE"})
+				},
+			},
+			"availability_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"security_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"crypt_key_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"export_location": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"available_capacity": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSFSTurboV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.sfsTurboV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud SFS Turbo client: %s", err)
+	}
+
+	createOpts := shares.CreateOpts{
+		Name:             d.Get("name").(string),
+		ShareProto:       d.Get("share_proto").(string),
+		ShareType:        d.Get("share_type").(string),
+		Size:             d.Get("size").(int),
+		AvailabilityZone: d.Get("availability_zone").(string),
+		VpcID:            d.Get("vpc_id").(string),
+		SubnetID:         d.Get("subnet_id").(string),
+		SecurityGroupID:  d.Get("security_group_id").(string),
+		Description:      d.Get("description").(string),
+	}
+	if v, ok := d.GetOk("crypt_key_id"); ok {
+		createOpts.Metadata = &shares.Metadata{
+			CryptKeyID: v.(string),
+		}
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	n, err := shares.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud SFS Turbo: %s", err)
+	}
+	d.SetId(n.ID)
+
+	log.Printf("[DEBUG] Waiting for OpenTelekomCloud SFS Turbo (%s) to become available", n.ID)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"100"},
+		Target:     []string{"200"},
+		Refresh:    waitForSFSTurboStatus(client, n.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      20 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenTelekomCloud SFS Turbo (%s) to become available: %s", n.ID, err)
+	}
+
+	return resourceSFSTurboV1Read(d, meta)
+}
+
+func resourceSFSTurboV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.sfsTurboV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud SFS Turbo client: %s", err)
+	}
+
+	n, err := shares.Get(client, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "SFS Turbo")
+	}
+
+	log.Printf("[DEBUG] Retrieved SFS Turbo %s: %#v", d.Id(), n)
+
+	d.Set("name", n.Name)
+	d.Set("share_proto", n.ShareProto)
+	d.Set("share_type", n.ShareType)
+	d.Set("availability_zone", n.AvailabilityZone)
+	d.Set("vpc_id", n.VpcID)
+	d.Set("subnet_id", n.SubnetID)
+	d.Set("security_group_id", n.SecurityGroupID)
+	d.Set("crypt_key_id", n.CryptKeyID)
+	d.Set("description", n.Description)
+	d.Set("status", n.Status)
+	d.Set("export_location", n.ExportLocation)
+	d.Set("available_capacity", n.AvailCapacity)
+	d.Set("version", n.Version)
+	d.Set("region", GetRegion(d, config))
+
+	// The size is returned as a float string, e.g. "500.00"
+	size, err := strconv.ParseFloat(n.Size, 64)
+	if err != nil {
+		return fmt.Errorf("Error parsing size %q of OpenTelekomCloud SFS Turbo (%s): %s", n.Size, d.Id(), err)
+	}
+	d.Set("size", int(size))
+
+	return nil
+}
+
+func resourceSFSTurboV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.sfsTurboV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud SFS Turbo client: %s", err)
+	}
+
+	if d.HasChange("size") {
+		old, newSize := d.GetChange("size")
+		if old.(int) > newSize.(int) {
+			return fmt.Errorf("Shrinking OpenTelekomCloud SFS Turbo size is not supported")
+		}
+
+		expandOpts := shares.ExpandOpts{
+			Extend: shares.ExtendOpts{NewSize: newSize.(int)},
+		}
+		err = shares.Expand(client, d.Id(), expandOpts).ExtractErr()
+		if err != nil {
+			return fmt.Errorf("Error expanding OpenTelekomCloud SFS Turbo size: %s", err)
+		}
+
+		log.Printf("[DEBUG] Waiting for OpenTelekomCloud SFS Turbo (%s) to be expanded", d.Id())
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"expanding"},
+			Target:     []string{"expanded"},
+			Refresh:    waitForSFSTurboExpand(client, d.Id(), newSize.(int)),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      10 * time.Second,
+			MinTimeout: 5 * time.Second,
+		}
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("Error waiting for OpenTelekomCloud SFS Turbo (%s) to be expanded: %s", d.Id(), err)
+		}
+	}
+
+	return resourceSFSTurboV1Read(d, meta)
+}
+
+func resourceSFSTurboV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.sfsTurboV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud SFS Turbo client: %s", err)
+	}
+
+	err = shares.Delete(client, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "SFS Turbo")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"100", "200"},
+		Target:     []string{"deleted"},
+		Refresh:    waitForSFSTurboStatus(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error deleting OpenTelekomCloud SFS Turbo (%s): %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForSFSTurboStatus(client *golangsdk.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		n, err := shares.Get(client, id).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				log.Printf("[INFO] Successfully deleted OpenTelekomCloud SFS Turbo %s", id)
+				return n, "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] OpenTelekomCloud SFS Turbo (%s) current status: %s", id, n.Status)
+		return n, n.Status, nil
+	}
+}
+
+// waitForSFSTurboExpand waits for the size of the file system to reach size.
+// The sub status of the previous action, or none, is returned until the
+// expansion has been picked up, so it is the size that tells when the
+// expansion is complete.
+func waitForSFSTurboExpand(client *golangsdk.ServiceClient, id string, size int) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		n, err := shares.Get(client, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] OpenTelekomCloud SFS Turbo (%s) current size: %s, sub status: %s", id, n.Size, n.SubStatus)
+		state, err := sfsTurboV1ExpandState(n, size)
+		return n, state, err
+	}
+}
+
+// sfsTurboV1ExpandState returns "expanded" once the file system n has the
+// given size and is no longer expanding, and "expanding" before.
+func sfsTurboV1ExpandState(n *shares.Turbo, size int) (string, error) {
+	if n.SubStatus == "322" {
+		return "", fmt.Errorf("Expansion of OpenTelekomCloud SFS Turbo (%s) failed", n.ID)
+	}
+
+	// The size is returned as a float string, e.g. "500.00"
+	current, err := strconv.ParseFloat(n.Size, 64)
+	if err != nil {
+		return "", fmt.Errorf("Error parsing size %q of OpenTelekomCloud SFS Turbo (%s): %s", n.Size, n.ID, err)
+	}
+	if int(current) < size || n.SubStatus == "121" {
+		return "expanding", nil
+	}
+	return "expanded", nil
+}
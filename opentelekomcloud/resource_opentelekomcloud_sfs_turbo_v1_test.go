@@ -0,0 +1,146 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/huaweicloud/golangsdk/openstack/sfs_turbo/v1/shares"
+)
+
+func TestAccOTCSFSTurboV1_basic(t *testing.T) {
+	var turbo shares.Turbo
+	name := fmt.Sprintf("sfs-turbo-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSFSTurboV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSFSTurboV1_basic(name, 500),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSTurboV1Exists("opentelekomcloud_sfs_turbo_v1.sfs_turbo_1", &turbo),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_sfs_turbo_v1.sfs_turbo_1", "name", name),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_sfs_turbo_v1.sfs_turbo_1", "share_type", "STANDARD"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_sfs_turbo_v1.sfs_turbo_1", "size", "500"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_sfs_turbo_v1.sfs_turbo_1", "status", "200"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccSFSTurboV1_basic(name, 600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSFSTurboV1Exists("opentelekomcloud_sfs_turbo_v1.sfs_turbo_1", &turbo),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_sfs_turbo_v1.sfs_turbo_1", "size", "600"),
+				),
+			},
+		},
+	})
+}
+
+func TestSFSTurboV1ExpandState(t *testing.T) {
+	cases := []struct {
+		name      string
+		size      string
+		subStatus string
+		want      string
+		err       bool
+	}{
+		{"not picked up", "500.00", "", "expanding", false},
+		{"previous expansion", "500.00", "221", "expanding", false},
+		{"expanding", "500.00", "121", "expanding", false},
+		{"resized while expanding", "600.00", "121", "expanding", false},
+		{"expanded", "600.00", "221", "expanded", false},
+		{"failed", "500.00", "322", "", true},
+	}
+
+	for _, tc := range cases {
+		n := &shares.Turbo{ID: "turbo", Size: tc.size, SubStatus: tc.subStatus}
+		got, err := sfsTurboV1ExpandState(n, 600)
+		if (err != nil) != tc.err {
+			t.Errorf("%s: unexpected error %v", tc.name, err)
+		}
+		if got != tc.want {
+			t.Errorf("%s: expected state %q, got %q", tc.name, tc.want, got)
+		}
+	}
+}
+
+func testAccCheckSFSTurboV1Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	client, err := config.sfsTurboV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud SFS Turbo client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_sfs_turbo_v1" {
+			continue
+		}
+
+		_, err := shares.Get(client, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("SFS Turbo still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSFSTurboV1Exists(n string, turbo *shares.Turbo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		client, err := config.sfsTurboV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud SFS Turbo client: %s", err)
+		}
+
+		found, err := shares.Get(client, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("SFS Turbo not found")
+		}
+
+		*turbo = *found
+
+		return nil
+	}
+}
+
+func testAccSFSTurboV1_basic(name string, size int) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_networking_secgroup_v2" "secgroup_1" {
+  name = "%s"
+  description = "sfs turbo security group"
+}
+
+resource "opentelekomcloud_sfs_turbo_v1" "sfs_turbo_1" {
+  name = "%s"
+  size = %d
+  share_proto = "NFS"
+  availability_zone = "eu-de-01"
+  vpc_id = "%s"
+  subnet_id = "%s"
+  security_group_id = "${opentelekomcloud_networking_secgroup_v2.secgroup_1.id}"
+}
+`, name, name, size, OS_VPC_ID, OS_NETWORK_ID)
+}
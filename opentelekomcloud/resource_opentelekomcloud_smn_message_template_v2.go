@@ -0,0 +1,144 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/smn/v2/templates"
+)
+
+func resourceSmnMessageTemplateV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSmnMessageTemplateV2Create,
+		Read:   resourceSmnMessageTemplateV2Read,
+		Update: resourceSmnMessageTemplateV2Update,
+		Delete: resourceSmnMessageTemplateV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"protocol": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateStringList(v, k, []string{"default", "email", "sms", "http", "https"})
+				},
+			},
+			"content": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"tag_names": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"update_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSmnMessageTemplateV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.SmnV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud smn client: %s", err)
+	}
+
+	createOpts := templates.CreateOps{
+		Name:     d.Get("name").(string),
+		Protocol: d.Get("protocol").(string),
+		Content:  d.Get("content").(string),
+	}
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	template, err := templates.Create(client, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating message template: %s", err)
+	}
+	log.Printf("[DEBUG] Create : template.ID %s", template.ID)
+
+	d.SetId(template.ID)
+
+	return resourceSmnMessageTemplateV2Read(d, meta)
+}
+
+func resourceSmnMessageTemplateV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.SmnV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud smn client: %s", err)
+	}
+
+	template, err := templates.Get(client, d.Id()).ExtractGet()
+	if err != nil {
+		return CheckDeleted(d, err, "message template")
+	}
+
+	log.Printf("[DEBUG] Retrieved message template %s: %#v", d.Id(), template)
+
+	d.Set("name", template.Name)
+	d.Set("protocol", template.Protocol)
+	d.Set("content", template.Content)
+	d.Set("tag_names", template.TagNames)
+	d.Set("update_time", template.UpdateTime)
+	d.Set("create_time", template.CreateTime)
+
+	return nil
+}
+
+func resourceSmnMessageTemplateV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.SmnV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud smn client: %s", err)
+	}
+
+	if d.HasChange("content") {
+		updateOpts := templates.UpdateOps{
+			Content: d.Get("content").(string),
+		}
+		log.Printf("[DEBUG] Updating message template %s", d.Id())
+
+		err = templates.Update(client, updateOpts, d.Id()).ExtractErr()
+		if err != nil {
+			return fmt.Errorf("Error updating message template %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceSmnMessageTemplateV2Read(d, meta)
+}
+
+func resourceSmnMessageTemplateV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.SmnV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud smn client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting message template %s", d.Id())
+
+	err = templates.Delete(client, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "message template")
+	}
+
+	log.Printf("[DEBUG] Successfully deleted message template %s", d.Id())
+	return nil
+}
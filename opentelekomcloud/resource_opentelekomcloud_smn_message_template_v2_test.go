@@ -0,0 +1,112 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/huaweicloud/golangsdk/openstack/smn/v2/templates"
+)
+
+func TestAccSMNV2MessageTemplate_basic(t *testing.T) {
+	var template templates.TemplateGet
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSMNMessageTemplateV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: TestAccSMNV2MessageTemplateConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSMNV2MessageTemplateExists("opentelekomcloud_smn_message_template_v2.template_1", &template),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_smn_message_template_v2.template_1", "name", "template_1"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_smn_message_template_v2.template_1", "tag_names.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: TestAccSMNV2MessageTemplateConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSMNV2MessageTemplateExists("opentelekomcloud_smn_message_template_v2.template_1", &template),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_smn_message_template_v2.template_1", "content",
+						"Alarm {alarm_name} of {resource} was triggered"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_smn_message_template_v2.template_1", "tag_names.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSMNMessageTemplateV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	smnClient, err := config.SmnV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud smn: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "opentelekomcloud_smn_message_template_v2" {
+			continue
+		}
+
+		_, err := templates.Get(smnClient, rs.Primary.ID).ExtractGet()
+		if err == nil {
+			return fmt.Errorf("Message template still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSMNV2MessageTemplateExists(n string, template *templates.TemplateGet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		smnClient, err := config.SmnV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenTelekomCloud smn client: %s", err)
+		}
+
+		found, err := templates.Get(smnClient, rs.Primary.ID).ExtractGet()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Message template not found")
+		}
+
+		*template = *found
+
+		return nil
+	}
+}
+
+var TestAccSMNV2MessageTemplateConfig_basic = `
+resource "opentelekomcloud_smn_message_template_v2" "template_1" {
+  name     = "template_1"
+  protocol = "default"
+  content  = "Alarm {alarm_name} was triggered"
+}
+`
+
+var TestAccSMNV2MessageTemplateConfig_update = `
+resource "opentelekomcloud_smn_message_template_v2" "template_1" {
+  name     = "template_1"
+  protocol = "default"
+  content  = "Alarm {alarm_name} of {resource} was triggered"
+}
+`
@@ -0,0 +1,125 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/huaweicloud/golangsdk/openstack/smn/v2/topics"
+)
+
+func resourceSmnMessageV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSmnMessageV2Create,
+		Read:   resourceSmnMessageV2Read,
+		Delete: resourceSmnMessageV2Delete,
+
+		Schema: map[string]*schema.Schema{
+			"topic_urn": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subject": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"message": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"message_structure", "message_template_name"},
+			},
+			"message_structure": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+				ConflictsWith:    []string{"message", "message_template_name"},
+			},
+			"message_template_name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"message", "message_structure"},
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+			"time_to_live": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					return ValidateIntRange(v, k, 1, 86400)
+				},
+			},
+			"triggers": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+			"message_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSmnMessageV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	client, err := config.SmnV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenTelekomCloud smn client: %s", err)
+	}
+
+	publishOpts := topics.PublishOps{
+		Subject:             d.Get("subject").(string),
+		Message:             d.Get("message").(string),
+		MessageStructure:    d.Get("message_structure").(string),
+		MessageTemplateName: d.Get("message_template_name").(string),
+	}
+	if publishOpts.Message == "" && publishOpts.MessageStructure == "" && publishOpts.MessageTemplateName == "" {
+		return fmt.Errorf("One of message, message_structure or message_template_name must be set")
+	}
+	if publishOpts.MessageTemplateName != "" {
+		publishOpts.Tags = make(map[string]string)
+		for k, v := range d.Get("tags").(map[string]interface{}) {
+			publishOpts.Tags[k] = v.(string)
+		}
+	}
+	if v, ok := d.GetOk("time_to_live"); ok {
+		publishOpts.TimeToLive = strconv.Itoa(v.(int))
+	}
+	log.Printf("[DEBUG] Publish Options: %#v", publishOpts)
+
+	topicUrn := d.Get("topic_urn").(string)
+	message, err := topics.Publish(client, publishOpts, topicUrn).Extract()
+	if err != nil {
+		return fmt.Errorf("Error publishing message to topic %s: %s", topicUrn, err)
+	}
+	log.Printf("[DEBUG] Publish : message.MessageId %s", message.MessageId)
+
+	d.SetId(message.MessageId)
+	d.Set("message_id", message.MessageId)
+
+	return resourceSmnMessageV2Read(d, meta)
+}
+
+// A published message can not be retrieved again, so there is nothing to refresh.
+func resourceSmnMessageV2Read(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+// A published message can not be recalled, destroying only removes it from the state.
+func resourceSmnMessageV2Delete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
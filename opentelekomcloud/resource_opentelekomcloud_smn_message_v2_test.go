@@ -0,0 +1,99 @@
+package opentelekomcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccSMNV2Message_basic(t *testing.T) {
+	var messageID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSMNTopicV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSMNV2MessageConfig_basic("v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSMNV2MessagePublished("opentelekomcloud_smn_message_v2.message_1", &messageID),
+					resource.TestCheckResourceAttrSet(
+						"opentelekomcloud_smn_message_v2.template_1", "message_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccSMNV2MessageConfig_basic("v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSMNV2MessageRepublished("opentelekomcloud_smn_message_v2.message_1", &messageID),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSMNV2MessagePublished(n string, messageID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		*messageID = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccCheckSMNV2MessageRepublished(n string, messageID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == *messageID {
+			return fmt.Errorf("Message was not published again after the triggers changed")
+		}
+		return nil
+	}
+}
+
+func testAccSMNV2MessageConfig_basic(version string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_smn_topic_v2" "topic_1" {
+  name         = "topic_1"
+  display_name = "The display name of topic_1"
+}
+
+resource "opentelekomcloud_smn_message_template_v2" "template_1" {
+  name     = "deploy_template"
+  protocol = "default"
+  content  = "Deployed version {version}"
+}
+
+resource "opentelekomcloud_smn_message_v2" "message_1" {
+  topic_urn = "${opentelekomcloud_smn_topic_v2.topic_1.id}"
+  subject   = "Deployment"
+  message   = "Deployed version %s"
+
+  triggers {
+    version = "%s"
+  }
+}
+
+resource "opentelekomcloud_smn_message_v2" "template_1" {
+  topic_urn             = "${opentelekomcloud_smn_topic_v2.topic_1.id}"
+  message_template_name = "${opentelekomcloud_smn_message_template_v2.template_1.name}"
+
+  tags {
+    version = "%s"
+  }
+}
+`, version, version, version)
+}
@@ -3,17 +3,32 @@ package opentelekomcloud
 import (
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 
+	"github.com/huaweicloud/golangsdk"
 	"github.com/huaweicloud/golangsdk/openstack/smn/v2/subscriptions"
 )
 
+// smnSubscriptionsPageLimit is the largest page of subscriptions returned by
+// the list requests.
+const smnSubscriptionsPageLimit = 100
+
 func resourceSubscription() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSubscriptionCreate,
 		Read:   resourceSubscriptionRead,
 		Delete: resourceSubscriptionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"topic_urn": &schema.Schema{
@@ -75,7 +90,14 @@ func resourceSubscriptionCreate(d *schema.ResourceData, meta interface{}) error
 	}
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 
-	subscription, err := subscriptions.Create(client, createOpts, topicUrn).Extract()
+	var subscription *subscriptions.Subscription
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		subscription, err = subscriptions.Create(client, createOpts, topicUrn).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
 	if err != nil {
 		return fmt.Errorf("Error getting subscription from result: %s", err)
 	}
@@ -99,9 +121,15 @@ func resourceSubscriptionDelete(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Deleting subscription %s", d.Id())
 
 	id := d.Id()
-	result := subscriptions.Delete(client, id)
-	if result.Err != nil {
-		return err
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		err := subscriptions.Delete(client, id).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return CheckDeleted(d, err, "subscription")
 	}
 
 	log.Printf("[DEBUG] Successfully deleted subscription %s", id)
@@ -118,13 +146,20 @@ func resourceSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Getting subscription %s", d.Id())
 
 	id := d.Id()
-	subscriptionslist, err := subscriptions.List(client).Extract()
+	subscriptionslist, err := listSMNSubscriptions(client, d.Get("topic_urn").(string))
 	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			log.Printf("[WARN] Removing subscription %s because its topic is already gone", id)
+			d.SetId("")
+			return nil
+		}
 		return fmt.Errorf("Error Get subscriptionslist: %s", err)
 	}
 	log.Printf("[DEBUG] list : subscriptionslist %#v", subscriptionslist)
+	found := false
 	for _, subscription := range subscriptionslist {
 		if subscription.SubscriptionUrn == id {
+			found = true
 			log.Printf("[DEBUG] subscription: %#v", subscription)
 			d.Set("topic_urn", subscription.TopicUrn)
 			d.Set("endpoint", subscription.Endpoint)
@@ -135,7 +170,42 @@ func resourceSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set("status", subscription.Status)
 		}
 	}
+	if !found {
+		log.Printf("[WARN] Removing subscription %s because it's already gone", id)
+		d.SetId("")
+		return nil
+	}
 
 	log.Printf("[DEBUG] Successfully get subscription %s", id)
 	return nil
 }
+
+// listSMNSubscriptions lists all the subscriptions of the topic, or of the
+// project if topicUrn is empty. The list requests return at most
+// smnSubscriptionsPageLimit subscriptions, so they are fetched page by page.
+func listSMNSubscriptions(client *golangsdk.ServiceClient, topicUrn string) ([]subscriptions.SubscriptionGet, error) {
+	var all []subscriptions.SubscriptionGet
+	for offset := 0; ; offset += smnSubscriptionsPageLimit {
+		var url string
+		if topicUrn != "" {
+			url = client.ServiceURL("topics", topicUrn, "subscriptions")
+		} else {
+			url = client.ServiceURL("subscriptions")
+		}
+		url += fmt.Sprintf("?offset=%d&limit=%d", offset, smnSubscriptionsPageLimit)
+
+		var r subscriptions.ListResult
+		_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{
+			MoreHeaders: subscriptions.RequestOpts.MoreHeaders,
+		})
+		page, err := r.Extract()
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, page...)
+		if len(page) < smnSubscriptionsPageLimit {
+			return all, nil
+		}
+	}
+}
@@ -1,14 +1,67 @@
 package opentelekomcloud
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/huaweicloud/golangsdk"
 	"github.com/huaweicloud/golangsdk/openstack/smn/v2/subscriptions"
 )
 
+func TestListSMNSubscriptions(t *testing.T) {
+	const total = 2*smnSubscriptionsPageLimit + 5
+	var paths []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		var page []subscriptions.SubscriptionGet
+		for i := offset; i < total && i < offset+limit; i++ {
+			page = append(page, subscriptions.SubscriptionGet{
+				SubscriptionUrn: fmt.Sprintf("urn:smn:sub-%d", i),
+			})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"subscriptions": page})
+	}))
+	defer server.Close()
+
+	client := &golangsdk.ServiceClient{
+		ProviderClient: &golangsdk.ProviderClient{},
+		Endpoint:       server.URL + "/",
+	}
+
+	all, err := listSMNSubscriptions(client, "urn:smn:topic")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != total {
+		t.Fatalf("Expected %d subscriptions, got %d", total, len(all))
+	}
+	if all[total-1].SubscriptionUrn != fmt.Sprintf("urn:smn:sub-%d", total-1) {
+		t.Fatalf("Unexpected last subscription: %#v", all[total-1])
+	}
+	if len(paths) != 3 || paths[0] != "/topics/urn:smn:topic/subscriptions" {
+		t.Fatalf("Unexpected requests: %#v", paths)
+	}
+
+	paths = nil
+	if _, err := listSMNSubscriptions(client, ""); err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) != 3 || paths[0] != "/subscriptions" {
+		t.Fatalf("Unexpected requests: %#v", paths)
+	}
+}
+
 // PASS
 func TestAccSMNV2Subscription_basic(t *testing.T) {
 	var subscription1 subscriptions.SubscriptionGet
@@ -3,9 +3,12 @@ package opentelekomcloud
 import (
 	"fmt"
 	"log"
+	"time"
 
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 
+	"github.com/huaweicloud/golangsdk"
 	"github.com/huaweicloud/golangsdk/openstack/smn/v2/topics"
 )
 
@@ -15,6 +18,15 @@ func resourceTopic() *schema.Resource {
 		Read:   resourceTopicRead,
 		Delete: resourceTopicDelete,
 		Update: resourceTopicUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -28,6 +40,16 @@ func resourceTopic() *schema.Resource {
 				Optional: true,
 				ForceNew: false,
 			},
+			"access_policy": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+			"introduction": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"topic_urn": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -74,12 +96,21 @@ func resourceTopicCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error getting topic from result: %s", err)
 	}
 	log.Printf("[DEBUG] Create : topic.TopicUrn %s", topic.TopicUrn)
-	if topic.TopicUrn != "" {
-		d.SetId(topic.TopicUrn)
-		return resourceTopicRead(d, meta)
+	if topic.TopicUrn == "" {
+		return fmt.Errorf("Unexpected conversion error in resourceTopicCreate.")
+	}
+	d.SetId(topic.TopicUrn)
+
+	for _, name := range topicAttributes {
+		if v, ok := d.GetOk(name); ok {
+			err = updateTopicAttribute(client, d.Id(), name, v.(string), d.Timeout(schema.TimeoutCreate))
+			if err != nil {
+				return err
+			}
+		}
 	}
 
-	return fmt.Errorf("Unexpected conversion error in resourceTopicCreate.")
+	return resourceTopicRead(d, meta)
 }
 
 func resourceTopicRead(d *schema.ResourceData, meta interface{}) error {
@@ -104,6 +135,14 @@ func resourceTopicRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("update_time", topicGet.UpdateTime)
 	d.Set("create_time", topicGet.CreateTime)
 
+	attributes, err := topics.GetAttributes(client, topicUrn).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving attributes of topic %s: %s", topicUrn, err)
+	}
+	for _, name := range topicAttributes {
+		d.Set(name, attributes[name])
+	}
+
 	return nil
 }
 
@@ -117,9 +156,15 @@ func resourceTopicDelete(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Deleting topic %s", d.Id())
 
 	id := d.Id()
-	result := topics.Delete(client, id)
-	if result.Err != nil {
-		return err
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		err := topics.Delete(client, id).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return CheckDeleted(d, err, "topic")
 	}
 
 	log.Printf("[DEBUG] Successfully deleted topic %s", id)
@@ -136,20 +181,52 @@ func resourceTopicUpdate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Updating topic %s", d.Id())
 	id := d.Id()
 
-	var updateOpts topics.UpdateOps
 	if d.HasChange("display_name") {
+		var updateOpts topics.UpdateOps
 		updateOpts.DisplayName = d.Get("display_name").(string)
+
+		topic, err := topics.Update(client, updateOpts, id).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating topic from result: %s", err)
+		}
+		log.Printf("[DEBUG] Update : topic.TopicUrn: %s", topic.TopicUrn)
 	}
 
-	topic, err := topics.Update(client, updateOpts, id).Extract()
-	if err != nil {
-		return fmt.Errorf("Error updating topic from result: %s", err)
+	for _, name := range topicAttributes {
+		if !d.HasChange(name) {
+			continue
+		}
+		err = updateTopicAttribute(client, id, name, d.Get(name).(string), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
 	}
 
-	log.Printf("[DEBUG] Update : topic.TopicUrn: %s", topic.TopicUrn)
-	if topic.TopicUrn != "" {
-		d.SetId(topic.TopicUrn)
-		return resourceTopicRead(d, meta)
+	return resourceTopicRead(d, meta)
+}
+
+// topicAttributes lists the topic attributes managed through the
+// attributes API, keyed by the same name in the schema.
+var topicAttributes = []string{"access_policy", "introduction"}
+
+// updateTopicAttribute sets an attribute of a topic, an empty value removes it.
+func updateTopicAttribute(client *golangsdk.ServiceClient, id, name, value string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Updating %s of topic %s", name, id)
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		var err error
+		if value == "" {
+			err = topics.DeleteAttribute(client, id, name).ExtractErr()
+		} else {
+			updateOpts := topics.UpdateAttributeOps{Value: value}
+			err = topics.UpdateAttribute(client, updateOpts, id, name).ExtractErr()
+		}
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating %s of topic %s: %s", name, id, err)
 	}
 	return nil
 }
@@ -43,6 +43,36 @@ func TestAccSMNV2Topic_basic(t *testing.T) {
 	})
 }
 
+func TestAccSMNV2Topic_accessPolicy(t *testing.T) {
+	var topic topics.TopicGet
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSMNTopicV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSMNV2TopicConfig_accessPolicy("ces"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSMNV2TopicExists("opentelekomcloud_smn_topic_v2.topic_1", &topic),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_smn_topic_v2.topic_1", "introduction", "Alarms of topic_1"),
+					resource.TestCheckResourceAttrSet(
+						"opentelekomcloud_smn_topic_v2.topic_1", "access_policy"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccSMNV2TopicConfig_accessPolicy("obs"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSMNV2TopicExists("opentelekomcloud_smn_topic_v2.topic_1", &topic),
+					resource.TestCheckResourceAttrSet(
+						"opentelekomcloud_smn_topic_v2.topic_1", "access_policy"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckSMNTopicV2Destroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	smnClient, err := config.SmnV2Client(OS_REGION_NAME)
@@ -109,3 +139,31 @@ resource "opentelekomcloud_smn_topic_v2" "topic_1" {
   display_name    = "The update display name of topic_1"
 }
 `
+
+func testAccSMNV2TopicConfig_accessPolicy(service string) string {
+	return fmt.Sprintf(`
+resource "opentelekomcloud_smn_topic_v2" "topic_1" {
+  name         = "topic_1"
+  display_name = "The display name of topic_1"
+  introduction = "Alarms of topic_1"
+
+  access_policy = <<POLICY
+{
+  "Version": "2016-09-07",
+  "Id": "__default_policy_ID",
+  "Statement": [
+    {
+      "Sid": "__service_pub_0",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": ["%s"]
+      },
+      "Action": ["SMN:Publish", "SMN:QueryTopicDetail"],
+      "Resource": "urn:smn:%s:%s:topic_1"
+    }
+  ]
+}
+POLICY
+}
+`, service, OS_REGION_NAME, OS_TENANT_ID)
+}
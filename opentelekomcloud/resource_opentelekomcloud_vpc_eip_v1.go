@@ -96,6 +96,8 @@ func resourceVpcEIPV1() *schema.Resource {
 				Optional: true,
 				ForceNew: false,
 			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -138,6 +140,16 @@ func resourceVpcEIPV1Create(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(eIP.ID)
 
+	if len(resourceTags(d, meta)) > 0 {
+		tagManager, err := vpcTagManager(d, config, "publicips")
+		if err != nil {
+			return err
+		}
+		if err := setResourceTags(d, meta, tagManager); err != nil {
+			return fmt.Errorf("Error setting tags of EIP %s: %s", eIP.ID, err)
+		}
+	}
+
 	return resourceVpcEIPV1Read(d, meta)
 }
 
@@ -179,6 +191,14 @@ func resourceVpcEIPV1Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("bandwidth", bW)
 	d.Set("region", GetRegion(d, config))
 
+	tagManager, err := vpcTagManager(d, config, "publicips")
+	if err != nil {
+		return err
+	}
+	if err := readResourceTags(d, meta, tagManager); err != nil {
+		return fmt.Errorf("Error fetching tags of EIP %s: %s", d.Id(), err)
+	}
+
 	return nil
 }
 
@@ -227,6 +247,16 @@ func resourceVpcEIPV1Update(d *schema.ResourceData, meta interface{}) error {
 
 	}
 
+	if resourceTagsChanged(d, meta) {
+		tagManager, err := vpcTagManager(d, config, "publicips")
+		if err != nil {
+			return err
+		}
+		if err := setResourceTags(d, meta, tagManager); err != nil {
+			return fmt.Errorf("Error updating tags of EIP %s: %s", d.Id(), err)
+		}
+	}
+
 	return resourceVpcEIPV1Read(d, meta)
 }
 
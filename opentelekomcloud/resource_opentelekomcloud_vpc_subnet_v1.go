@@ -103,6 +103,8 @@ func resourceVpcSubnetV1() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -151,6 +153,16 @@ func resourceVpcSubnetV1Create(d *schema.ResourceData, meta interface{}) error {
 			n.ID, stateErr)
 	}
 
+	if len(resourceTags(d, meta)) > 0 {
+		tagManager, err := vpcTagManager(d, config, "subnets")
+		if err != nil {
+			return err
+		}
+		if err := setResourceTags(d, meta, tagManager); err != nil {
+			return fmt.Errorf("Error setting tags of OpenTelekomCloud VPC Subnet %s: %s", n.ID, err)
+		}
+	}
+
 	return resourceVpcSubnetV1Read(d, config)
 
 }
@@ -184,6 +196,14 @@ func resourceVpcSubnetV1Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("subnet_id", n.SubnetId)
 	d.Set("region", GetRegion(d, config))
 
+	tagManager, err := vpcTagManager(d, config, "subnets")
+	if err != nil {
+		return err
+	}
+	if err := readResourceTags(d, meta, tagManager); err != nil {
+		return fmt.Errorf("Error retrieving tags of OpenTelekomCloud VPC Subnet %s: %s", d.Id(), err)
+	}
+
 	return nil
 }
 
@@ -222,6 +242,16 @@ func resourceVpcSubnetV1Update(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error updating OpenTelekomCloud VPC Subnet: %s", err)
 	}
 
+	if resourceTagsChanged(d, meta) {
+		tagManager, err := vpcTagManager(d, config, "subnets")
+		if err != nil {
+			return err
+		}
+		if err := setResourceTags(d, meta, tagManager); err != nil {
+			return fmt.Errorf("Error updating tags of OpenTelekomCloud VPC Subnet %s: %s", d.Id(), err)
+		}
+	}
+
 	return resourceVpcSubnetV1Read(d, meta)
 }
 
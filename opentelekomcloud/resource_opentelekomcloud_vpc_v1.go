@@ -53,6 +53,8 @@ func resourceVirtualPrivateCloudV1() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -95,6 +97,16 @@ func resourceVirtualPrivateCloudV1Create(d *schema.ResourceData, meta interface{
 			n.ID, stateErr)
 	}
 
+	if len(resourceTags(d, meta)) > 0 {
+		tagManager, err := vpcTagManager(d, config, "vpcs")
+		if err != nil {
+			return err
+		}
+		if err := setResourceTags(d, meta, tagManager); err != nil {
+			return fmt.Errorf("Error setting tags of OpenTelekomCloud Vpc %s: %s", n.ID, err)
+		}
+	}
+
 	return resourceVirtualPrivateCloudV1Read(d, meta)
 
 }
@@ -123,6 +135,14 @@ func resourceVirtualPrivateCloudV1Read(d *schema.ResourceData, meta interface{})
 	d.Set("shared", n.EnableSharedSnat)
 	d.Set("region", GetRegion(d, config))
 
+	tagManager, err := vpcTagManager(d, config, "vpcs")
+	if err != nil {
+		return err
+	}
+	if err := readResourceTags(d, meta, tagManager); err != nil {
+		return fmt.Errorf("Error retrieving tags of OpenTelekomCloud Vpc %s: %s", d.Id(), err)
+	}
+
 	return nil
 }
 
@@ -147,6 +167,16 @@ func resourceVirtualPrivateCloudV1Update(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error updating OpenTelekomCloud Vpc: %s", err)
 	}
 
+	if resourceTagsChanged(d, meta) {
+		tagManager, err := vpcTagManager(d, config, "vpcs")
+		if err != nil {
+			return err
+		}
+		if err := setResourceTags(d, meta, tagManager); err != nil {
+			return fmt.Errorf("Error updating tags of OpenTelekomCloud Vpc %s: %s", d.Id(), err)
+		}
+	}
+
 	return resourceVirtualPrivateCloudV1Read(d, meta)
 }
 
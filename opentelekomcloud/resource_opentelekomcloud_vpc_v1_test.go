@@ -65,6 +65,40 @@ func TestAccOTCVpcV1_update(t *testing.T) {
 	})
 }
 
+func TestAccOTCVpcV1_tags(t *testing.T) {
+	var vpc vpcs.Vpc
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOTCVpcV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpcV1_tags,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOTCVpcV1Exists("opentelekomcloud_vpc_v1.vpc_1", &vpc),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_v1.vpc_1", "tags.%", "2"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_v1.vpc_1", "tags.foo", "bar"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_v1.vpc_1", "tags.key", "value"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccVpcV1_tagsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOTCVpcV1Exists("opentelekomcloud_vpc_v1.vpc_1", &vpc),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_v1.vpc_1", "tags.%", "1"),
+					resource.TestCheckResourceAttr(
+						"opentelekomcloud_vpc_v1.vpc_1", "tags.foo", "bar2"),
+				),
+			},
+		},
+	})
+}
+
 // PASS
 func TestAccOTCVpcV1_timeout(t *testing.T) {
 	var vpc vpcs.Vpc
@@ -150,6 +184,30 @@ resource "opentelekomcloud_vpc_v1" "vpc_1" {
 	cidr="192.168.0.0/16"
 }
 `
+
+const testAccVpcV1_tags = `
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+	name = "terraform_provider_test"
+	cidr="192.168.0.0/16"
+
+	tags = {
+		foo = "bar"
+		key = "value"
+	}
+}
+`
+
+const testAccVpcV1_tagsUpdate = `
+resource "opentelekomcloud_vpc_v1" "vpc_1" {
+	name = "terraform_provider_test"
+	cidr="192.168.0.0/16"
+
+	tags = {
+		foo = "bar2"
+	}
+}
+`
+
 const testAccVpcV1_timeout = `
 resource "opentelekomcloud_vpc_v1" "vpc_1" {
 	name = "terraform_provider_test"
@@ -0,0 +1,133 @@
+package opentelekomcloud
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/private/protocol"
+	"github.com/aws/aws-sdk-go/private/protocol/restxml"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// The vendored aws-sdk-go predates the default bucket encryption API, so the
+// operations and shapes are declared here in the same way the SDK does.
+
+type serverSideEncryptionConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	Rules []*serverSideEncryptionRule `locationName:"Rule" type:"list" flattened:"true" required:"true"`
+}
+
+type serverSideEncryptionRule struct {
+	_ struct{} `type:"structure"`
+
+	ApplyServerSideEncryptionByDefault *serverSideEncryptionByDefault `type:"structure"`
+}
+
+type serverSideEncryptionByDefault struct {
+	_ struct{} `type:"structure"`
+
+	KMSMasterKeyID *string `type:"string"`
+
+	SSEAlgorithm *string `type:"string" required:"true"`
+}
+
+type putBucketEncryptionInput struct {
+	_ struct{} `type:"structure" payload:"ServerSideEncryptionConfiguration"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ServerSideEncryptionConfiguration *serverSideEncryptionConfiguration `locationName:"ServerSideEncryptionConfiguration" type:"structure" required:"true" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+}
+
+type putBucketEncryptionOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+type getBucketEncryptionInput struct {
+	_ struct{} `type:"structure"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+}
+
+type getBucketEncryptionOutput struct {
+	_ struct{} `type:"structure" payload:"ServerSideEncryptionConfiguration"`
+
+	ServerSideEncryptionConfiguration *serverSideEncryptionConfiguration `type:"structure"`
+}
+
+type deleteBucketEncryptionInput struct {
+	_ struct{} `type:"structure"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+}
+
+type deleteBucketEncryptionOutput struct {
+	_ struct{} `type:"structure"`
+}
+
+// putBucketEncryption sets the default server-side encryption of a bucket.
+func putBucketEncryption(conn *s3.S3, bucket *string, config *serverSideEncryptionConfiguration) error {
+	op := &request.Operation{
+		Name:       "PutBucketEncryption",
+		HTTPMethod: "PUT",
+		HTTPPath:   "/{Bucket}?encryption",
+	}
+	input := &putBucketEncryptionInput{
+		Bucket:                            bucket,
+		ServerSideEncryptionConfiguration: config,
+	}
+
+	req := conn.NewRequest(op, input, &putBucketEncryptionOutput{})
+	req.Handlers.Build.PushBack(s3ContentMD5)
+	req.Handlers.Unmarshal.Remove(restxml.UnmarshalHandler)
+	req.Handlers.Unmarshal.PushBackNamed(protocol.UnmarshalDiscardBodyHandler)
+	return req.Send()
+}
+
+// getBucketEncryption returns the default server-side encryption of a bucket.
+func getBucketEncryption(conn *s3.S3, bucket *string) (*serverSideEncryptionConfiguration, error) {
+	op := &request.Operation{
+		Name:       "GetBucketEncryption",
+		HTTPMethod: "GET",
+		HTTPPath:   "/{Bucket}?encryption",
+	}
+	output := &getBucketEncryptionOutput{}
+
+	req := conn.NewRequest(op, &getBucketEncryptionInput{Bucket: bucket}, output)
+	if err := req.Send(); err != nil {
+		return nil, err
+	}
+	return output.ServerSideEncryptionConfiguration, nil
+}
+
+// deleteBucketEncryption removes the default server-side encryption of a bucket.
+func deleteBucketEncryption(conn *s3.S3, bucket *string) error {
+	op := &request.Operation{
+		Name:       "DeleteBucketEncryption",
+		HTTPMethod: "DELETE",
+		HTTPPath:   "/{Bucket}?encryption",
+	}
+
+	req := conn.NewRequest(op, &deleteBucketEncryptionInput{Bucket: bucket}, &deleteBucketEncryptionOutput{})
+	req.Handlers.Unmarshal.Remove(restxml.UnmarshalHandler)
+	req.Handlers.Unmarshal.PushBackNamed(protocol.UnmarshalDiscardBodyHandler)
+	return req.Send()
+}
+
+// s3ContentMD5 sets the Content-MD5 header the encryption operations require.
+func s3ContentMD5(r *request.Request) {
+	h := md5.New()
+	if _, err := io.Copy(h, r.Body); err != nil {
+		r.Error = awserr.New("ContentMD5", "failed to read body", err)
+		return
+	}
+	if _, err := r.Body.Seek(0, 0); err != nil {
+		r.Error = awserr.New("ContentMD5", "failed to seek body", err)
+		return
+	}
+	r.HTTPRequest.Header.Set("Content-MD5", base64.StdEncoding.EncodeToString(h.Sum(nil)))
+}
@@ -0,0 +1,398 @@
+package opentelekomcloud
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+const (
+	s3MultipartMinPartSize = 5 * 1024 * 1024
+	s3MultipartMaxPartSize = 5 * 1024 * 1024 * 1024
+	s3MultipartMaxParts    = 10000
+
+	// s3MultipartSettingsPart is the number of the part holding the settings
+	// of an upload, which cannot be read back from an incomplete upload. It is
+	// left out when completing the upload, so it is not part of the object.
+	s3MultipartSettingsPart = s3MultipartMaxParts
+)
+
+// s3MultipartUploader uploads large objects in parallel parts. An upload which
+// fails half-way is kept in the bucket, and the next upload of the same key
+// resumes it if it has the same settings and the parts already uploaded match
+// the local content.
+type s3MultipartUploader struct {
+	conn        *s3.S3
+	partSize    int64
+	concurrency int
+}
+
+// s3Part is the byte range of a single part, numbered from 1.
+type s3Part struct {
+	number int64
+	offset int64
+	size   int64
+}
+
+// s3MultipartParts splits an object of the given size into parts.
+func s3MultipartParts(size, partSize int64) []s3Part {
+	parts := make([]s3Part, 0, (size+partSize-1)/partSize)
+	for offset, number := int64(0), int64(1); offset < size; offset, number = offset+partSize, number+1 {
+		n := partSize
+		if size-offset < n {
+			n = size - offset
+		}
+		parts = append(parts, s3Part{number: number, offset: offset, size: n})
+	}
+	return parts
+}
+
+// upload uploads body as the object described by input.
+func (u *s3MultipartUploader) upload(input *s3.CreateMultipartUploadInput, body io.ReaderAt, size int64) (*s3.CompleteMultipartUploadOutput, error) {
+	bucket := aws.StringValue(input.Bucket)
+	key := aws.StringValue(input.Key)
+
+	parts := s3MultipartParts(size, u.partSize)
+	if len(parts) >= s3MultipartSettingsPart {
+		return nil, fmt.Errorf("%s would be uploaded in %d parts, the maximum is %d: increase multipart_part_size",
+			key, len(parts), s3MultipartSettingsPart-1)
+	}
+
+	settings := s3MultipartSettings(input)
+	uploadID, completed, err := u.resumableUpload(bucket, key, settings, body, parts)
+	if err != nil {
+		return nil, err
+	}
+	if uploadID == "" {
+		out, err := u.conn.CreateMultipartUpload(input)
+		if err != nil {
+			return nil, fmt.Errorf("Error creating multipart upload of %s: %s", key, err)
+		}
+		uploadID = aws.StringValue(out.UploadId)
+		completed = make(map[int64]string)
+
+		settingsPart := s3Part{number: s3MultipartSettingsPart, size: int64(len(settings))}
+		if _, err := u.uploadPart(bucket, key, uploadID, bytes.NewReader(settings), settingsPart); err != nil {
+			if err := abortS3MultipartUpload(u.conn, bucket, key, uploadID); err != nil {
+				log.Printf("[WARN] %s", err)
+			}
+			return nil, fmt.Errorf("Error uploading the settings of multipart upload %s of %s: %s", uploadID, key, err)
+		}
+		log.Printf("[DEBUG] Created multipart upload %s of %s in %d parts", uploadID, key, len(parts))
+	} else {
+		log.Printf("[DEBUG] Resuming multipart upload %s of %s, %d of %d parts already uploaded",
+			uploadID, key, len(completed), len(parts))
+	}
+
+	if err := u.uploadParts(bucket, key, uploadID, body, parts, completed); err != nil {
+		return nil, fmt.Errorf("Error uploading %s, the incomplete upload %s will be resumed by the next apply: %s",
+			key, uploadID, err)
+	}
+
+	completedParts := make([]*s3.CompletedPart, 0, len(parts))
+	for _, p := range parts {
+		completedParts = append(completedParts, &s3.CompletedPart{
+			PartNumber: aws.Int64(p.number),
+			ETag:       aws.String(completed[p.number]),
+		})
+	}
+
+	out, err := u.conn.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(bucket),
+		Key:             aws.String(key),
+		UploadId:        aws.String(uploadID),
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: completedParts},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error completing multipart upload %s of %s: %s", uploadID, key, err)
+	}
+
+	return out, nil
+}
+
+// resumableUpload looks for an incomplete upload of key with the given
+// settings whose uploaded parts all match the local content. It returns its ID
+// and the ETags of the uploaded parts, or an empty ID if there is none.
+// Incomplete uploads which cannot be resumed are aborted.
+func (u *s3MultipartUploader) resumableUpload(bucket, key string, settings []byte, body io.ReaderAt, parts []s3Part) (string, map[int64]string, error) {
+	uploads, err := listS3MultipartUploads(u.conn, bucket, key)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Try the most recent upload first.
+	sort.Slice(uploads, func(i, j int) bool {
+		return aws.TimeValue(uploads[i].Initiated).After(aws.TimeValue(uploads[j].Initiated))
+	})
+
+	resumeID := ""
+	var completed map[int64]string
+	for _, upload := range uploads {
+		uploadID := aws.StringValue(upload.UploadId)
+		if resumeID == "" {
+			completed, err = u.matchingParts(bucket, key, uploadID, settings, body, parts)
+			if err != nil {
+				return "", nil, err
+			}
+			if completed != nil {
+				resumeID = uploadID
+				continue
+			}
+		}
+
+		log.Printf("[DEBUG] Aborting incomplete multipart upload %s of %s", uploadID, key)
+		if err := abortS3MultipartUpload(u.conn, bucket, key, uploadID); err != nil {
+			return "", nil, err
+		}
+	}
+
+	return resumeID, completed, nil
+}
+
+// matchingParts returns the ETags of the uploaded parts of an incomplete
+// upload, or nil if its settings or any of them differ from the local ones.
+// An upload without settings part was started with unknown settings and is
+// never resumed.
+func (u *s3MultipartUploader) matchingParts(bucket, key, uploadID string, settings []byte, body io.ReaderAt, parts []s3Part) (map[int64]string, error) {
+	settingsSum := md5.Sum(settings)
+	settingsMatch := false
+	completed := make(map[int64]string)
+	input := &s3.ListPartsInput{
+		Bucket:   aws.String(bucket),
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadID),
+	}
+	for {
+		out, err := u.conn.ListParts(input)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing parts of multipart upload %s of %s: %s", uploadID, key, err)
+		}
+
+		for _, remote := range out.Parts {
+			number := aws.Int64Value(remote.PartNumber)
+			if number == s3MultipartSettingsPart {
+				if strings.Trim(aws.StringValue(remote.ETag), `"`) != hex.EncodeToString(settingsSum[:]) {
+					return nil, nil
+				}
+				settingsMatch = true
+				continue
+			}
+			if number < 1 || number > int64(len(parts)) {
+				return nil, nil
+			}
+			local := parts[number-1]
+			if aws.Int64Value(remote.Size) != local.size {
+				return nil, nil
+			}
+			sum, err := s3PartMD5(body, local)
+			if err != nil {
+				return nil, err
+			}
+			// The ETag of a part encrypted with KMS is not its MD5, so such
+			// uploads are never resumed.
+			etag := aws.StringValue(remote.ETag)
+			if strings.Trim(etag, `"`) != hex.EncodeToString(sum) {
+				return nil, nil
+			}
+			completed[number] = etag
+		}
+
+		if !aws.BoolValue(out.IsTruncated) {
+			if !settingsMatch {
+				return nil, nil
+			}
+			return completed, nil
+		}
+		input.PartNumberMarker = out.NextPartNumberMarker
+	}
+}
+
+// uploadParts uploads the parts missing from completed with up to
+// u.concurrency requests in parallel, and records their ETags in completed.
+func (u *s3MultipartUploader) uploadParts(bucket, key, uploadID string, body io.ReaderAt, parts []s3Part, completed map[int64]string) error {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+
+	queue := make(chan s3Part)
+	for i := 0; i < u.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for p := range queue {
+				etag, err := u.uploadPart(bucket, key, uploadID, body, p)
+
+				mu.Lock()
+				if err != nil && firstErr == nil {
+					firstErr = err
+				} else if err == nil {
+					completed[p.number] = etag
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, p := range parts {
+		mu.Lock()
+		_, done := completed[p.number]
+		failed := firstErr != nil
+		mu.Unlock()
+
+		if failed {
+			break
+		}
+		if !done {
+			queue <- p
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	return firstErr
+}
+
+func (u *s3MultipartUploader) uploadPart(bucket, key, uploadID string, body io.ReaderAt, p s3Part) (string, error) {
+	log.Printf("[DEBUG] Uploading part %d (%d bytes) of %s", p.number, p.size, key)
+	out, err := u.conn.UploadPart(&s3.UploadPartInput{
+		Bucket:        aws.String(bucket),
+		Key:           aws.String(key),
+		UploadId:      aws.String(uploadID),
+		PartNumber:    aws.Int64(p.number),
+		ContentLength: aws.Int64(p.size),
+		Body:          io.NewSectionReader(body, p.offset, p.size),
+	})
+	if err != nil {
+		return "", fmt.Errorf("part %d: %s", p.number, err)
+	}
+	return aws.StringValue(out.ETag), nil
+}
+
+func s3PartMD5(body io.ReaderAt, p s3Part) ([]byte, error) {
+	h := md5.New()
+	if _, err := io.Copy(h, io.NewSectionReader(body, p.offset, p.size)); err != nil {
+		return nil, fmt.Errorf("Error reading part %d: %s", p.number, err)
+	}
+	return h.Sum(nil), nil
+}
+
+// listS3MultipartUploads returns the incomplete multipart uploads of key.
+func listS3MultipartUploads(conn *s3.S3, bucket, key string) ([]*s3.MultipartUpload, error) {
+	var uploads []*s3.MultipartUpload
+	input := &s3.ListMultipartUploadsInput{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(key),
+	}
+	for {
+		out, err := conn.ListMultipartUploads(input)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing multipart uploads of %s: %s", key, err)
+		}
+
+		for _, upload := range out.Uploads {
+			if aws.StringValue(upload.Key) == key {
+				uploads = append(uploads, upload)
+			}
+		}
+
+		if !aws.BoolValue(out.IsTruncated) {
+			return uploads, nil
+		}
+		input.KeyMarker = out.NextKeyMarker
+		input.UploadIdMarker = out.NextUploadIdMarker
+	}
+}
+
+func abortS3MultipartUpload(conn *s3.S3, bucket, key, uploadID string) error {
+	_, err := conn.AbortMultipartUpload(&s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(bucket),
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadID),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == s3.ErrCodeNoSuchUpload {
+			return nil
+		}
+		return fmt.Errorf("Error aborting multipart upload %s of %s: %s", uploadID, key, err)
+	}
+	return nil
+}
+
+// abortS3MultipartUploads aborts all incomplete multipart uploads of key.
+func abortS3MultipartUploads(conn *s3.S3, bucket, key string) error {
+	uploads, err := listS3MultipartUploads(conn, bucket, key)
+	if err != nil {
+		return err
+	}
+	for _, upload := range uploads {
+		log.Printf("[DEBUG] Aborting incomplete multipart upload %s of %s", aws.StringValue(upload.UploadId), key)
+		if err := abortS3MultipartUpload(conn, bucket, key, aws.StringValue(upload.UploadId)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// s3MultipartUploadInput returns the input to start a multipart upload with
+// the same headers as put.
+func s3MultipartUploadInput(put *s3.PutObjectInput) *s3.CreateMultipartUploadInput {
+	return &s3.CreateMultipartUploadInput{
+		Bucket:                  put.Bucket,
+		Key:                     put.Key,
+		ACL:                     put.ACL,
+		CacheControl:            put.CacheControl,
+		ContentDisposition:      put.ContentDisposition,
+		ContentEncoding:         put.ContentEncoding,
+		ContentLanguage:         put.ContentLanguage,
+		ContentType:             put.ContentType,
+		Metadata:                put.Metadata,
+		ServerSideEncryption:    put.ServerSideEncryption,
+		SSEKMSKeyId:             put.SSEKMSKeyId,
+		StorageClass:            put.StorageClass,
+		WebsiteRedirectLocation: put.WebsiteRedirectLocation,
+	}
+}
+
+// s3MultipartSettings returns the settings of a multipart upload which are not
+// part of its content, in a stable form: an incomplete upload is only resumed
+// with the same settings.
+func s3MultipartSettings(input *s3.CreateMultipartUploadInput) []byte {
+	var b bytes.Buffer
+	field := func(name string, v *string) {
+		fmt.Fprintf(&b, "%s=%q\n", name, aws.StringValue(v))
+	}
+
+	field("acl", input.ACL)
+	field("cache_control", input.CacheControl)
+	field("content_disposition", input.ContentDisposition)
+	field("content_encoding", input.ContentEncoding)
+	field("content_language", input.ContentLanguage)
+	field("content_type", input.ContentType)
+	field("server_side_encryption", input.ServerSideEncryption)
+	field("sse_kms_key_id", input.SSEKMSKeyId)
+	field("storage_class", input.StorageClass)
+	field("website_redirect", input.WebsiteRedirectLocation)
+
+	keys := make([]string, 0, len(input.Metadata))
+	for k := range input.Metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		field("metadata."+strings.ToLower(k), input.Metadata[k])
+	}
+
+	return b.Bytes()
+}
@@ -0,0 +1,103 @@
+package opentelekomcloud
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+func TestS3MultipartParts(t *testing.T) {
+	cases := []struct {
+		size, partSize int64
+		expected       []s3Part
+	}{
+		{
+			size:     0,
+			partSize: 5,
+			expected: []s3Part{},
+		},
+		{
+			size:     5,
+			partSize: 5,
+			expected: []s3Part{{number: 1, offset: 0, size: 5}},
+		},
+		{
+			size:     12,
+			partSize: 5,
+			expected: []s3Part{
+				{number: 1, offset: 0, size: 5},
+				{number: 2, offset: 5, size: 5},
+				{number: 3, offset: 10, size: 2},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		parts := s3MultipartParts(tc.size, tc.partSize)
+		if !reflect.DeepEqual(parts, tc.expected) {
+			t.Errorf("size %d, part size %d: expected %#v, got %#v", tc.size, tc.partSize, tc.expected, parts)
+		}
+	}
+}
+
+func TestIsS3ContentMD5(t *testing.T) {
+	cases := []struct {
+		etag     string
+		sse      *string
+		expected bool
+	}{
+		{"d41d8cd98f00b204e9800998ecf8427e", nil, true},
+		{"d41d8cd98f00b204e9800998ecf8427e", aws.String(s3.ServerSideEncryptionAes256), true},
+		{"d41d8cd98f00b204e9800998ecf8427e", aws.String(s3.ServerSideEncryptionAwsKms), false},
+		{"0e9a8d5d5b1d9e3c2e3c5f7a0b4e9f12-3", nil, false},
+		{"", nil, false},
+	}
+
+	for _, tc := range cases {
+		if actual := isS3ContentMD5(tc.etag, tc.sse); actual != tc.expected {
+			t.Errorf("%q (%s): expected %t, got %t", tc.etag, aws.StringValue(tc.sse), tc.expected, actual)
+		}
+	}
+}
+
+func TestS3MultipartSettings(t *testing.T) {
+	input := func() *s3.CreateMultipartUploadInput {
+		return &s3.CreateMultipartUploadInput{
+			Bucket:      aws.String("bucket"),
+			Key:         aws.String("key"),
+			ACL:         aws.String("private"),
+			ContentType: aws.String("text/plain"),
+			Metadata: map[string]*string{
+				"b": aws.String("2"),
+				"a": aws.String("1"),
+			},
+		}
+	}
+
+	expected := "acl=\"private\"\ncache_control=\"\"\ncontent_disposition=\"\"\ncontent_encoding=\"\"\n" +
+		"content_language=\"\"\ncontent_type=\"text/plain\"\nserver_side_encryption=\"\"\n" +
+		"sse_kms_key_id=\"\"\nstorage_class=\"\"\nwebsite_redirect=\"\"\nmetadata.a=\"1\"\nmetadata.b=\"2\"\n"
+	if got := string(s3MultipartSettings(input())); got != expected {
+		t.Fatalf("Expected settings %q, got %q", expected, got)
+	}
+
+	changes := []func(*s3.CreateMultipartUploadInput){
+		func(i *s3.CreateMultipartUploadInput) { i.ACL = aws.String("public-read") },
+		func(i *s3.CreateMultipartUploadInput) { i.ContentType = aws.String("text/html") },
+		func(i *s3.CreateMultipartUploadInput) { i.Metadata["a"] = aws.String("3") },
+		func(i *s3.CreateMultipartUploadInput) {
+			i.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
+		},
+		func(i *s3.CreateMultipartUploadInput) { i.SSEKMSKeyId = aws.String("key-id") },
+		func(i *s3.CreateMultipartUploadInput) { i.StorageClass = aws.String(s3.StorageClassStandardIa) },
+	}
+	for n, change := range changes {
+		changed := input()
+		change(changed)
+		if string(s3MultipartSettings(changed)) == expected {
+			t.Errorf("%d: expected the settings to change", n)
+		}
+	}
+}
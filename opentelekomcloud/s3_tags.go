@@ -10,69 +10,41 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-// setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
-func setTagsS3(conn *s3.S3, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsS3(tagsFromMapS3(o), tagsFromMapS3(n))
+// setTagsS3 is a helper to set the tags for a bucket. It expects the
+// tags field to be named "tags", the default tags of the provider are
+// merged into them. The tag set of a bucket is always replaced as a whole.
+func setTagsS3(conn *s3.S3, d *schema.ResourceData, meta interface{}) error {
+	if !resourceTagsChanged(d, meta) {
+		return nil
+	}
 
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			_, err := retryOnAwsCodes([]string{"NoSuchBucket", "OperationAborted"}, func() (interface{}, error) {
-				return conn.DeleteBucketTagging(&s3.DeleteBucketTaggingInput{
-					Bucket: aws.String(d.Get("bucket").(string)),
-				})
-			})
-			if err != nil {
-				return err
-			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			req := &s3.PutBucketTaggingInput{
+	tags := resourceTags(d, meta)
+	if len(tags) == 0 {
+		log.Printf("[DEBUG] Removing tags")
+		_, err := retryOnAwsCodes([]string{"NoSuchBucket", "OperationAborted"}, func() (interface{}, error) {
+			return conn.DeleteBucketTagging(&s3.DeleteBucketTaggingInput{
 				Bucket: aws.String(d.Get("bucket").(string)),
-				Tagging: &s3.Tagging{
-					TagSet: create,
-				},
-			}
-
-			_, err := retryOnAwsCodes([]string{"NoSuchBucket", "OperationAborted"}, func() (interface{}, error) {
-				return conn.PutBucketTagging(req)
 			})
-			if err != nil {
-				return err
-			}
-		}
+		})
+		return err
 	}
 
-	return nil
-}
-
-// diffTags takes our tags locally and the ones remotely and returns
-// the set of tags that must be created, and the set of tags that must
-// be destroyed.
-func diffTagsS3(oldTags, newTags []*s3.Tag) ([]*s3.Tag, []*s3.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
+	tagSet := make(map[string]interface{}, len(tags))
+	for k, v := range tags {
+		tagSet[k] = v
 	}
-
-	// Build the list of what to remove
-	var remove []*s3.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
+	log.Printf("[DEBUG] Setting tags: %#v", tags)
+	req := &s3.PutBucketTaggingInput{
+		Bucket: aws.String(d.Get("bucket").(string)),
+		Tagging: &s3.Tagging{
+			TagSet: tagsFromMapS3(tagSet),
+		},
 	}
 
-	return tagsFromMapS3(create), remove
+	_, err := retryOnAwsCodes([]string{"NoSuchBucket", "OperationAborted"}, func() (interface{}, error) {
+		return conn.PutBucketTagging(req)
+	})
+	return err
 }
 
 // tagsFromMap returns the tags for the given map of data.
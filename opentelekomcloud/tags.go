@@ -1,18 +1,13 @@
 package opentelekomcloud
 
 import (
+	"fmt"
 	"log"
-	"regexp"
-	"strings"
-	"time"
-
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	//"github.com/aws/aws-sdk-go/service/dynamodb"
-	"github.com/aws/aws-sdk-go/service/ec2"
-	//"github.com/aws/aws-sdk-go/service/elbv2"
-	"github.com/hashicorp/terraform/helper/resource"
+	"sort"
+
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/common/tags"
 )
 
 // tagsSchema returns the schema to use for tags.
@@ -32,121 +27,218 @@ func tagsSchemaComputed() *schema.Schema {
 	}
 }
 
-// setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
-func setTags(conn *ec2.EC2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
-				_, err := conn.DeleteTags(&ec2.DeleteTagsInput{
-					Resources: []*string{aws.String(d.Id())},
-					Tags:      remove,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				return err
+// tagsAllSchema returns the schema of the tags_all attribute, the tags of a
+// resource including the default tags of the provider.
+func tagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+	}
+}
+
+// expandProviderDefaultTags returns the tags of the default_tags block of
+// the provider.
+func expandProviderDefaultTags(l []interface{}) map[string]string {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	return expandTagsMap(l[0].(map[string]interface{})["tags"].(map[string]interface{}))
+}
+
+// expandTagsMap turns the map of a tags attribute into a map of strings.
+func expandTagsMap(m map[string]interface{}) map[string]string {
+	result := make(map[string]string, len(m))
+	for k, v := range m {
+		result[k] = v.(string)
+	}
+	return result
+}
+
+// mergeTags returns the default tags overridden by the tags of a resource.
+func mergeTags(defaults, resourceTags map[string]string) map[string]string {
+	result := make(map[string]string, len(defaults)+len(resourceTags))
+	for k, v := range defaults {
+		result[k] = v
+	}
+	for k, v := range resourceTags {
+		result[k] = v
+	}
+	return result
+}
+
+// filterDefaultTags returns the remote tags without the ones equal to a
+// default tag, unless they are configured on the resource as well. A default
+// tag missing from the remote tags is returned with an empty value, so that
+// the tags show as changed until it is applied.
+func filterDefaultTags(remote, defaults map[string]string, configured map[string]interface{}) map[string]string {
+	result := make(map[string]string, len(remote))
+	for k, v := range remote {
+		if def, ok := defaults[k]; ok && def == v {
+			if _, ok := configured[k]; !ok {
+				continue
 			}
 		}
-		if len(create) > 0 {
-			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
-				_, err := conn.CreateTags(&ec2.CreateTagsInput{
-					Resources: []*string{aws.String(d.Id())},
-					Tags:      create,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				return err
-			}
+		result[k] = v
+	}
+	for k := range defaults {
+		_, isRemote := remote[k]
+		_, isConfigured := configured[k]
+		if !isRemote && !isConfigured {
+			result[k] = ""
 		}
 	}
+	return result
+}
 
-	return nil
+// resourceTags returns the tags to apply to the resource of d: the default
+// tags of the provider, overridden by the tags argument of the resource.
+func resourceTags(d *schema.ResourceData, meta interface{}) map[string]string {
+	return mergeTags(meta.(*Config).DefaultTags, expandTagsMap(d.Get("tags").(map[string]interface{})))
+}
+
+// stateTags returns the remote tags to save in the tags attribute of d. The
+// default tags of the provider are left out, so that they do not show up as a
+// diff of every resource. A default tag with a different remote value or
+// missing from the resource is kept, the next apply restores it.
+func stateTags(d *schema.ResourceData, meta interface{}, remote map[string]string) map[string]string {
+	return filterDefaultTags(remote, meta.(*Config).DefaultTags, d.Get("tags").(map[string]interface{}))
+}
+
+// setStateTags saves the remote tags of the resource of d in its tags and
+// tags_all attributes.
+func setStateTags(d *schema.ResourceData, meta interface{}, remote map[string]string) error {
+	if err := d.Set("tags", stateTags(d, meta, remote)); err != nil {
+		return err
+	}
+	return d.Set("tags_all", remote)
 }
 
-// diffTags takes our tags locally and the ones remotely and returns
-// the set of tags that must be created, and the set of tags that must
-// be destroyed.
-func diffTags(oldTags, newTags []*ec2.Tag) ([]*ec2.Tag, []*ec2.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
+// diffTags returns the tags to create and the tags to remove to turn the old
+// tags into the new ones. Creating a tag replaces the value of an existing
+// tag, so a changed tag is only created.
+func diffTags(oldTags, newTags map[string]string) (map[string]string, map[string]string) {
+	create := make(map[string]string)
+	for k, v := range newTags {
+		if old, ok := oldTags[k]; !ok || old != v {
+			create[k] = v
+		}
 	}
 
-	// Build the list of what to remove
-	var remove []*ec2.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			remove = append(remove, t)
+	remove := make(map[string]string)
+	for k, v := range oldTags {
+		if _, ok := newTags[k]; !ok {
+			remove[k] = v
 		}
 	}
 
-	return tagsFromMap(create), remove
+	return create, remove
 }
 
-// tagsFromMap returns the tags for the given map of data.
-func tagsFromMap(m map[string]interface{}) []*ec2.Tag {
-	result := make([]*ec2.Tag, 0, len(m))
+// expandResourceTags returns the tags of the map, sorted by key.
+func expandResourceTags(m map[string]string) []tags.ResourceTag {
+	result := make([]tags.ResourceTag, 0, len(m))
 	for k, v := range m {
-		t := &ec2.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnored(t) {
-			result = append(result, t)
-		}
+		result = append(result, tags.ResourceTag{
+			Key:   k,
+			Value: v,
+		})
 	}
-
+	sort.Slice(result, func(i, j int) bool { return result[i].Key < result[j].Key })
 	return result
 }
 
-// tagsToMap turns the list of tags into a map.
-func tagsToMap(ts []*ec2.Tag) map[string]string {
-	result := make(map[string]string)
+// flattenResourceTags turns the list of tags into a map.
+func flattenResourceTags(ts []tags.ResourceTag) map[string]string {
+	result := make(map[string]string, len(ts))
 	for _, t := range ts {
-		if !tagIgnored(t) {
-			result[*t.Key] = *t.Value
-		}
+		result[t.Key] = t.Value
 	}
-
 	return result
 }
 
-// tagIgnored compares a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnored(t *ec2.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
+// tagManager manages the tags of the resources of a service through the tag
+// management API shared by the OpenTelekomCloud services. The volumes and the
+// buckets have their own tag APIs and apply resourceTags themselves; the
+// string tags of the images are not key/value tags and do not get the default
+// tags.
+type tagManager struct {
+	client *golangsdk.ServiceClient
+	// srvType is the path of the resource collection, e.g. "{project_id}/vpcs".
+	srvType string
+}
+
+// newProjectTagManager returns a tagManager for the collection of a service
+// whose resource paths start with the project ID.
+func newProjectTagManager(client *golangsdk.ServiceClient, collection string) *tagManager {
+	return &tagManager{
+		client:  client,
+		srvType: fmt.Sprintf("%s/%s", client.ProjectID, collection),
+	}
+}
+
+// get returns the tags of the resource.
+func (m *tagManager) get(id string) (map[string]string, error) {
+	ts, err := tags.Get(m.client, m.srvType, id).Extract()
+	if err != nil {
+		return nil, err
+	}
+	return flattenResourceTags(ts), nil
+}
+
+// update changes the tags of the resource to the given ones, creating and
+// removing only the tags which differ from the remote ones.
+func (m *tagManager) update(id string, desired map[string]string) error {
+	remote, err := m.get(id)
+	if err != nil {
+		return err
+	}
+
+	create, remove := diffTags(remote, desired)
+	if len(remove) > 0 {
+		log.Printf("[DEBUG] Removing tags of %s %s: %#v", m.srvType, id, remove)
+		if err := tags.Delete(m.client, m.srvType, id, expandResourceTags(remove)).ExtractErr(); err != nil {
+			return err
+		}
+	}
+	if len(create) > 0 {
+		log.Printf("[DEBUG] Creating tags of %s %s: %#v", m.srvType, id, create)
+		if err := tags.Create(m.client, m.srvType, id, expandResourceTags(create)).ExtractErr(); err != nil {
+			return err
 		}
 	}
-	return false
+
+	return nil
+}
+
+// setResourceTags applies the tags argument and the default tags of the
+// provider to the resource of d.
+func setResourceTags(d *schema.ResourceData, meta interface{}, m *tagManager) error {
+	return m.update(d.Id(), resourceTags(d, meta))
+}
+
+// readResourceTags saves the remote tags of the resource of d in its tags and
+// tags_all attributes.
+func readResourceTags(d *schema.ResourceData, meta interface{}, m *tagManager) error {
+	remote, err := m.get(d.Id())
+	if err != nil {
+		return err
+	}
+	return setStateTags(d, meta, remote)
+}
+
+// resourceTagsChanged reports whether the tags of the resource of d must be
+// applied on update. With default tags, they are applied on every update, so
+// that default tags added to the provider reach the existing resources.
+func resourceTagsChanged(d *schema.ResourceData, meta interface{}) bool {
+	return d.HasChange("tags") || len(meta.(*Config).DefaultTags) > 0
+}
+
+// vpcTagManager returns the tagManager of a collection of the VPC service,
+// e.g. "vpcs", "subnets" or "publicips".
+func vpcTagManager(d *schema.ResourceData, config *Config, collection string) (*tagManager, error) {
+	client, err := config.hwNetworkV2Client(GetRegion(d, config))
+	if err != nil {
+		return nil, fmt.Errorf("Error creating OpenTelekomCloud networking client: %s", err)
+	}
+	return newProjectTagManager(client, collection), nil
 }
@@ -0,0 +1,178 @@
+package opentelekomcloud
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/huaweicloud/golangsdk/openstack/common/tags"
+)
+
+func TestDiffTags(t *testing.T) {
+	cases := []struct {
+		Old, New       map[string]string
+		Create, Remove map[string]string
+	}{
+		// Basic add/remove
+		{
+			Old:    map[string]string{"foo": "bar"},
+			New:    map[string]string{"bar": "baz"},
+			Create: map[string]string{"bar": "baz"},
+			Remove: map[string]string{"foo": "bar"},
+		},
+
+		// Modify, creating a tag replaces its value
+		{
+			Old:    map[string]string{"foo": "bar"},
+			New:    map[string]string{"foo": "baz"},
+			Create: map[string]string{"foo": "baz"},
+			Remove: map[string]string{},
+		},
+
+		// Unchanged
+		{
+			Old:    map[string]string{"foo": "bar", "env": "test"},
+			New:    map[string]string{"env": "test", "foo": "bar"},
+			Create: map[string]string{},
+			Remove: map[string]string{},
+		},
+
+		// Remove all
+		{
+			Old:    map[string]string{"foo": "bar"},
+			New:    nil,
+			Create: map[string]string{},
+			Remove: map[string]string{"foo": "bar"},
+		},
+	}
+
+	for i, tc := range cases {
+		c, r := diffTags(tc.Old, tc.New)
+		if !reflect.DeepEqual(c, tc.Create) {
+			t.Fatalf("%d: bad create: %#v", i, c)
+		}
+		if !reflect.DeepEqual(r, tc.Remove) {
+			t.Fatalf("%d: bad remove: %#v", i, r)
+		}
+	}
+}
+
+func TestMergeTags(t *testing.T) {
+	defaults := map[string]string{"env": "test", "owner": "team"}
+	resourceTags := map[string]string{"owner": "me", "app": "web"}
+
+	expected := map[string]string{"env": "test", "owner": "me", "app": "web"}
+	if got := mergeTags(defaults, resourceTags); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Expected merged tags %#v, got %#v", expected, got)
+	}
+	if got := mergeTags(nil, nil); len(got) != 0 {
+		t.Fatalf("Expected no tags, got %#v", got)
+	}
+	if defaults["owner"] != "team" {
+		t.Fatalf("Expected the default tags not to be modified, got %#v", defaults)
+	}
+}
+
+func TestFilterDefaultTags(t *testing.T) {
+	defaults := map[string]string{"env": "test", "owner": "team"}
+
+	cases := []struct {
+		Remote     map[string]string
+		Configured map[string]interface{}
+		Expected   map[string]string
+	}{
+		// Default tags are left out
+		{
+			Remote:     map[string]string{"env": "test", "owner": "team", "app": "web"},
+			Configured: map[string]interface{}{"app": "web"},
+			Expected:   map[string]string{"app": "web"},
+		},
+
+		// Configured tags equal to a default are kept
+		{
+			Remote:     map[string]string{"env": "test", "owner": "team"},
+			Configured: map[string]interface{}{"owner": "team"},
+			Expected:   map[string]string{"owner": "team"},
+		},
+
+		// Default tags with a different value are kept
+		{
+			Remote:     map[string]string{"env": "prod", "owner": "team"},
+			Configured: map[string]interface{}{},
+			Expected:   map[string]string{"env": "prod"},
+		},
+
+		// Default tags missing from the resource are kept empty
+		{
+			Remote:     map[string]string{"owner": "team"},
+			Configured: map[string]interface{}{},
+			Expected:   map[string]string{"env": ""},
+		},
+
+		// Unless they are configured
+		{
+			Remote:     map[string]string{"owner": "team"},
+			Configured: map[string]interface{}{"env": "prod"},
+			Expected:   map[string]string{},
+		},
+	}
+
+	for i, tc := range cases {
+		got := filterDefaultTags(tc.Remote, defaults, tc.Configured)
+		if !reflect.DeepEqual(got, tc.Expected) {
+			t.Fatalf("%d: expected %#v, got %#v", i, tc.Expected, got)
+		}
+	}
+}
+
+func TestResourceTags(t *testing.T) {
+	meta := &Config{
+		DefaultTags: map[string]string{"env": "test", "owner": "team"},
+	}
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"tags": tagsSchema()}, map[string]interface{}{
+		"tags": map[string]interface{}{"owner": "me"},
+	})
+
+	expected := map[string]string{"env": "test", "owner": "me"}
+	if got := resourceTags(d, meta); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Expected tags %#v, got %#v", expected, got)
+	}
+
+	remote := map[string]string{"env": "test", "owner": "me", "manual": "yes"}
+	expected = map[string]string{"owner": "me", "manual": "yes"}
+	if got := stateTags(d, meta, remote); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Expected state tags %#v, got %#v", expected, got)
+	}
+}
+
+func TestExpandProviderDefaultTags(t *testing.T) {
+	if got := expandProviderDefaultTags(nil); got != nil {
+		t.Fatalf("Expected no default tags, got %#v", got)
+	}
+
+	l := []interface{}{
+		map[string]interface{}{
+			"tags": map[string]interface{}{"env": "test"},
+		},
+	}
+	expected := map[string]string{"env": "test"}
+	if got := expandProviderDefaultTags(l); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Expected default tags %#v, got %#v", expected, got)
+	}
+}
+
+func TestExpandResourceTags(t *testing.T) {
+	got := expandResourceTags(map[string]string{"b": "2", "a": "1", "c": ""})
+	expected := []tags.ResourceTag{
+		{Key: "a", Value: "1"},
+		{Key: "b", Value: "2"},
+		{Key: "c", Value: ""},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Expected tags %#v, got %#v", expected, got)
+	}
+
+	if m := flattenResourceTags(got); !reflect.DeepEqual(m, map[string]string{"a": "1", "b": "2", "c": ""}) {
+		t.Fatalf("Unexpected flattened tags: %#v", m)
+	}
+}
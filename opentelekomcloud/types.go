@@ -14,6 +14,7 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/l7policies"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
@@ -193,6 +194,33 @@ func (opts FloatingIPCreateOpts) ToFloatingIPCreateMap() (map[string]interface{}
 	return BuildRequest(opts, "floatingip")
 }
 
+// L7PolicyUpdateOpts represents the attributes used when updating an L7 policy.
+type L7PolicyUpdateOpts struct {
+	l7policies.UpdateOpts
+	// ClearRedirectPoolID and ClearRedirectListenerID unset the redirect
+	// targets, which l7policies.UpdateOpts leaves out when empty.
+	ClearRedirectPoolID     bool `json:"-"`
+	ClearRedirectListenerID bool `json:"-"`
+}
+
+// ToL7PolicyUpdateMap casts an L7PolicyUpdateOpts struct to a map.
+// It overrides l7policies.ToL7PolicyUpdateMap to send null redirect targets.
+func (opts L7PolicyUpdateOpts) ToL7PolicyUpdateMap() (map[string]interface{}, error) {
+	b, err := opts.UpdateOpts.ToL7PolicyUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	l7policy := b["l7policy"].(map[string]interface{})
+	if opts.ClearRedirectPoolID {
+		l7policy["redirect_pool_id"] = nil
+	}
+	if opts.ClearRedirectListenerID {
+		l7policy["redirect_listener_id"] = nil
+	}
+	return b, nil
+}
+
 // KeyPairCreateOpts represents the attributes used when creating a new keypair.
 type KeyPairCreateOpts struct {
 	keypairs.CreateOpts
@@ -66,6 +66,17 @@ func validateS3BucketLifecycleTimestamp(v interface{}, k string) (ws []string, e
 	return
 }
 
+func validateRFC3339Timestamp(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	_, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be parsed as RFC3339 Timestamp Format", value))
+	}
+
+	return
+}
+
 func validateS3BucketLifecycleExpirationDays(v interface{}, k string) (ws []string, errors []error) {
 	if v.(int) <= 0 {
 		errors = append(errors, fmt.Errorf(
@@ -84,6 +95,15 @@ func validateS3BucketLifecycleRuleId(v interface{}, k string) (ws []string, erro
 	return
 }
 
+func validateS3BucketReplicationRuleId(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) > 255 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot exceed 255 characters", k))
+	}
+	return
+}
+
 func validateJsonString(v interface{}, k string) (ws []string, errors []error) {
 	if _, err := normalizeJsonString(v); err != nil {
 		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))